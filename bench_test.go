@@ -0,0 +1,113 @@
+package validate
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+var benchSink ValidationResult
+
+func BenchmarkSuccess(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink = Success()
+	}
+}
+
+func BenchmarkRules(b *testing.B) {
+	re := regexp.MustCompile(`^[a-z]+$`)
+	rules := []struct {
+		name string
+		v    Validator
+	}{
+		{"NonEmpty", NonEmpty("hello")},
+		{"MinLen", MinLen("hello", 3)},
+		{"Matches", Matches("hello", re)},
+		{"IntBetween", IntBetween(5, 1, 10)},
+		{"EmailValid", EmailValid("user@example.com")},
+		{"IsUUIDv4", IsUUIDv4("550e8400-e29b-41d4-a716-446655440000")},
+		{"MinLenFail", MinLen("hi", 3)},
+	}
+	for _, r := range rules {
+		b.Run(r.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchSink = r.v.Validate()
+			}
+		})
+	}
+}
+
+func BenchmarkMatchesString(b *testing.B) {
+	b.Run("Cached", func(b *testing.B) {
+		v := MatchesString("hello", `^[a-z]+$`)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink = v.Validate()
+		}
+	})
+	b.Run("CompileEachTime", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink = Matches("hello", regexp.MustCompile(`^[a-z]+$`)).Validate()
+		}
+	})
+}
+
+func benchChain(n int, fail bool) *FluentValidator {
+	f := New()
+	for i := 0; i < n; i++ {
+		f.And(MinLen("hello", 3))
+	}
+	if fail {
+		f.Or(MinLen("hi", 3))
+	}
+	return f
+}
+
+func BenchmarkFluentValidate(b *testing.B) {
+	cases := []struct {
+		name string
+		f    *FluentValidator
+	}{
+		{"And4Pass", benchChain(4, false)},
+		{"And64Pass", benchChain(64, false)},
+		{"Or64Fail", func() *FluentValidator {
+			f := New()
+			for i := 0; i < 64; i++ {
+				f.Or(MinLen("hi", 3))
+			}
+			return f
+		}()},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchSink = c.f.Validate()
+			}
+		})
+	}
+}
+
+func BenchmarkValidateParallel(b *testing.B) {
+	slow := ValidatorFunc(func() ValidationResult {
+		time.Sleep(100 * time.Microsecond)
+		return Success()
+	})
+	f := New()
+	for i := 0; i < 16; i++ {
+		f.And(slow)
+	}
+	b.Run("Sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSink = f.Validate()
+		}
+	})
+	b.Run("Parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSink = f.ValidateParallel(8)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"math/big"
+	"regexp"
+)
+
+// Arbitrary-precision number rules. A nil value or bound fails with
+// "must not be nil".
+
+// BigIntMin validates that v >= min.
+func BigIntMin(v, min *big.Int) ValidatorFunc {
+	return func() ValidationResult {
+		if v == nil || min == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		if v.Cmp(min) < 0 {
+			return FailCode("number.min", "must be >= "+min.String(), "min", min)
+		}
+		return Success()
+	}
+}
+
+// BigIntBetween validates that min <= v <= max.
+func BigIntBetween(v, min, max *big.Int) ValidatorFunc {
+	return func() ValidationResult {
+		if v == nil || min == nil || max == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		if v.Cmp(min) < 0 || v.Cmp(max) > 0 {
+			return FailCode("number.between", "must be between "+min.String()+" and "+max.String(), "min", min, "max", max)
+		}
+		return Success()
+	}
+}
+
+// IsProbablyPrimeBig validates that v is prime according to
+// (*big.Int).ProbablyPrime with rounds Miller-Rabin rounds (plus a
+// Baillie-PSW test); composites pass with probability at most 4^-rounds.
+func IsProbablyPrimeBig(v *big.Int, rounds int) ValidatorFunc {
+	return func() ValidationResult {
+		if v == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		if rounds < 0 || !v.ProbablyPrime(rounds) {
+			return FailCode("number.prime", "must be prime")
+		}
+		return Success()
+	}
+}
+
+// BigFloatMin validates that v >= min.
+func BigFloatMin(v, min *big.Float) ValidatorFunc {
+	return func() ValidationResult {
+		if v == nil || min == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		if v.Cmp(min) < 0 {
+			return FailCode("number.min", "must be >= "+min.Text('g', -1), "min", min)
+		}
+		return Success()
+	}
+}
+
+// BigFloatBetween validates that min <= v <= max.
+func BigFloatBetween(v, min, max *big.Float) ValidatorFunc {
+	return func() ValidationResult {
+		if v == nil || min == nil || max == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		if v.Cmp(min) < 0 || v.Cmp(max) > 0 {
+			return FailCode("number.between", "must be between "+min.Text('g', -1)+" and "+max.Text('g', -1), "min", min, "max", max)
+		}
+		return Success()
+	}
+}
+
+// reDecimal is the plain decimal grammar parseDecimal accepts: an optional
+// sign, digits with an optional fraction, and an optional exponent.
+var reDecimal = regexp.MustCompile(`^[+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)(?:[eE][+-]?[0-9]+)?$`)
+
+// parseDecimal parses s as an exact decimal number such as "-12.5" or
+// "1e-3". Forms big.Rat would also accept, like fractions ("1/3"), hex,
+// binary and octal prefixes and digit separators, are rejected.
+func parseDecimal(s string) (*big.Rat, bool) {
+	if !reDecimal.MatchString(s) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// NumericStringMin validates that the decimal string s is >= min. Both are
+// compared exactly, without converting to float64.
+func NumericStringMin(s, min string) ValidatorFunc {
+	return func() ValidationResult {
+		lo, ok := parseDecimal(min)
+		if !ok {
+			return FailCode("number.invalid_bound", "invalid bound: "+min, "bound", min)
+		}
+		v, ok := parseDecimal(s)
+		if !ok {
+			return FailCode("string.numeric", "must be numeric")
+		}
+		if v.Cmp(lo) < 0 {
+			return FailCode("number.min", "must be >= "+min, "min", min)
+		}
+		return Success()
+	}
+}
+
+// NumericStringBetween validates that min <= s <= max, comparing the decimal
+// strings exactly.
+func NumericStringBetween(s, min, max string) ValidatorFunc {
+	return func() ValidationResult {
+		lo, ok := parseDecimal(min)
+		if !ok {
+			return FailCode("number.invalid_bound", "invalid bound: "+min, "bound", min)
+		}
+		hi, ok := parseDecimal(max)
+		if !ok {
+			return FailCode("number.invalid_bound", "invalid bound: "+max, "bound", max)
+		}
+		v, ok := parseDecimal(s)
+		if !ok {
+			return FailCode("string.numeric", "must be numeric")
+		}
+		if v.Cmp(lo) < 0 || v.Cmp(hi) > 0 {
+			return FailCode("number.between", "must be between "+min+" and "+max, "min", min, "max", max)
+		}
+		return Success()
+	}
+}
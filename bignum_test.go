@@ -0,0 +1,109 @@
+package validate
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestBigNumberRules(t *testing.T) {
+	t.Parallel()
+	bigInt := func(s string) *big.Int {
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			t.Fatalf("bad big.Int %q", s)
+		}
+		return n
+	}
+	bigFloat := func(s string) *big.Float {
+		f, ok := new(big.Float).SetPrec(200).SetString(s)
+		if !ok {
+			t.Fatalf("bad big.Float %q", s)
+		}
+		return f
+	}
+	// Both well above math.MaxInt64 (9223372036854775807).
+	huge := bigInt("100000000000000000000")
+	hugePlus := bigInt("100000000000000000001")
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"BigIntMin ok", BigIntMin(hugePlus, huge), true, nil},
+		{"BigIntMin equal", BigIntMin(huge, huge), true, nil},
+		{"BigIntMin fail", BigIntMin(huge, hugePlus), false, []string{"must be >= 100000000000000000001"}},
+		{"BigIntMin nil", BigIntMin(nil, huge), false, []string{"must not be nil"}},
+		{"BigIntBetween ok", BigIntBetween(hugePlus, huge, bigInt("200000000000000000000")), true, nil},
+		{"BigIntBetween fail", BigIntBetween(bigInt("9223372036854775808"), huge, hugePlus), false, []string{"must be between 100000000000000000000 and 100000000000000000001"}},
+		{"BigIntBetween nil", BigIntBetween(nil, huge, hugePlus), false, []string{"must not be nil"}},
+		{"BigFloatMin ok", BigFloatMin(bigFloat("1e30"), bigFloat("1e20")), true, nil},
+		{"BigFloatMin fail", BigFloatMin(bigFloat("99999999999999999999.5"), bigFloat("1e20")), false, []string{"must be >= 1e+20"}},
+		{"BigFloatMin nil", BigFloatMin(nil, bigFloat("1")), false, []string{"must not be nil"}},
+		{"BigFloatBetween ok", BigFloatBetween(bigFloat("1.5e20"), bigFloat("1e20"), bigFloat("2e20")), true, nil},
+		{"BigFloatBetween fail", BigFloatBetween(bigFloat("3e20"), bigFloat("1e20"), bigFloat("2e20")), false, []string{"must be between 1e+20 and 2e+20"}},
+		{"BigFloatBetween nil", BigFloatBetween(bigFloat("1"), nil, bigFloat("2")), false, []string{"must not be nil"}},
+		// 2^127-1 is a Mersenne prime; 2^128+1 is divisible by 59649589127497217.
+		{"IsProbablyPrimeBig mersenne", IsProbablyPrimeBig(bigInt("170141183460469231731687303715884105727"), 20), true, nil},
+		{"IsProbablyPrimeBig small", IsProbablyPrimeBig(big.NewInt(13), 0), true, nil},
+		{"IsProbablyPrimeBig composite", IsProbablyPrimeBig(bigInt("340282366920938463463374607431768211457"), 20), false, []string{"must be prime"}},
+		{"IsProbablyPrimeBig negative", IsProbablyPrimeBig(big.NewInt(-13), 20), false, []string{"must be prime"}},
+		{"IsProbablyPrimeBig nil", IsProbablyPrimeBig(nil, 20), false, []string{"must not be nil"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestNumericStringRules(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"min ok", NumericStringMin("10.5", "10"), true, nil},
+		{"min equal", NumericStringMin("1e3", "1000"), true, nil},
+		{"min fail", NumericStringMin("-0.01", "0"), false, []string{"must be >= 0"}},
+		// Both round to the same float64 (9007199254740992).
+		{"min beyond float64 precision", NumericStringMin("9007199254740992", "9007199254740993"), false, []string{"must be >= 9007199254740993"}},
+		{"min beyond float64 decimals", NumericStringMin("0.10000000000000000001", "0.1"), true, nil},
+		{"min malformed", NumericStringMin("12abc", "0"), false, []string{"must be numeric"}},
+		{"min fraction rejected", NumericStringMin("1/3", "0"), false, []string{"must be numeric"}},
+		{"min empty", NumericStringMin("", "0"), false, []string{"must be numeric"}},
+		{"min hex", NumericStringMin("0x10", "1"), false, []string{"must be numeric"}},
+		{"min hex float", NumericStringMin("0x1p4", "1"), false, []string{"must be numeric"}},
+		{"min underscore", NumericStringMin("0x1_0", "1"), false, []string{"must be numeric"}},
+		{"min decimal underscore", NumericStringMin("1_000", "1"), false, []string{"must be numeric"}},
+		{"min binary", NumericStringMin("0b101", "1"), false, []string{"must be numeric"}},
+		{"min octal", NumericStringMin("0o17", "1"), false, []string{"must be numeric"}},
+		{"min leading dot", NumericStringMin(".5", "0.1"), true, nil},
+		{"min signed exponent", NumericStringMin("+2.5E+1", "25"), true, nil},
+		{"min hex bound", NumericStringMin("20", "0x10"), false, []string{"invalid bound: 0x10"}},
+		{"min bad bound", NumericStringMin("1", "x"), false, []string{"invalid bound: x"}},
+		{"between ok", NumericStringBetween("99.99", "0", "100"), true, nil},
+		{"between fail", NumericStringBetween("100.000000000000000001", "0", "100"), false, []string{"must be between 0 and 100"}},
+		{"between malformed", NumericStringBetween("1,000", "0", "100"), false, []string{"must be numeric"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
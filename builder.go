@@ -0,0 +1,67 @@
+package validate
+
+// ResultBuilder accumulates failures imperatively, for code that validates
+// dynamic data (e.g. rows of a CSV) where building a chain is awkward. The
+// zero value is ready to use; methods return the builder for chaining.
+type ResultBuilder struct {
+	messages []string
+	failures []failure
+	invalid  bool // set by any recorded failure, even one without messages
+}
+
+// AddError records a failure message.
+func (b *ResultBuilder) AddError(msg string) *ResultBuilder {
+	b.invalid = true
+	b.messages, b.failures = appendFailures(b.messages, b.failures, Fail(msg))
+	return b
+}
+
+// AddIf records msg when cond is true, i.e. cond describes the error.
+func (b *ResultBuilder) AddIf(cond bool, msg string) *ResultBuilder {
+	if cond {
+		b.AddError(msg)
+	}
+	return b
+}
+
+// Require runs v and records its failures, keeping their codes.
+func (b *ResultBuilder) Require(v Validator) *ResultBuilder {
+	if res := v.Validate(); !res.IsValid {
+		b.invalid = true
+		b.messages, b.failures = appendFailures(b.messages, b.failures, res)
+	}
+	return b
+}
+
+// addAt records the failures of res as belonging to the value at path: each
+// message is prefixed with "path: " and path is reported as Failure.Field.
+// An empty path records res unchanged.
+func (b *ResultBuilder) addAt(path string, res ValidationResult) {
+	if res.IsValid {
+		return
+	}
+	b.invalid = true
+	if path == "" {
+		b.messages, b.failures = appendFailures(b.messages, b.failures, res)
+		return
+	}
+	for i, m := range res.Message {
+		fl := res.failureAt(i)
+		fl.field = path
+		b.messages = append(b.messages, path+": "+m)
+		b.failures = append(b.failures, fl)
+	}
+}
+
+// Result returns the accumulated result: valid when no failure was recorded.
+// The builder can keep being used afterwards.
+func (b *ResultBuilder) Result() ValidationResult {
+	if !b.invalid {
+		return Success()
+	}
+	messages := make([]string, len(b.messages))
+	copy(messages, b.messages)
+	failures := make([]failure, len(b.failures))
+	copy(failures, b.failures)
+	return ValidationResult{IsValid: false, Message: messages, failures: failures}
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestResultBuilder(t *testing.T) {
+	t.Parallel()
+
+	var empty ResultBuilder
+	if res := empty.Result(); !res.IsValid || len(res.Message) != 0 {
+		t.Fatalf("empty builder result=%+v", res)
+	}
+
+	rows := [][]string{
+		{"ada@example.com", "36"},
+		{"", "12"},
+		{"bob@example", "abc"},
+	}
+	var b ResultBuilder
+	for i, row := range rows {
+		line := "row " + strconv.Itoa(i+1) + ": "
+		b.AddIf(row[0] == "", line+"email is required")
+		if row[0] != "" {
+			b.Require(Named(line+"email", EmailValid(row[0])))
+		}
+		age, err := strconv.Atoi(row[1])
+		b.AddIf(err != nil, line+"age must be a number")
+		if err == nil {
+			b.Require(IntMin(age, 18))
+		}
+	}
+	res := b.Result()
+	want := []string{
+		"row 2: email is required",
+		"must be >= 18",
+		"row 3: email: invalid email",
+		"row 3: age must be a number",
+	}
+	if res.IsValid || !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("result=%+v want %v", res, want)
+	}
+	if !res.HasCode("number.min") || !res.HasCode("email.invalid") {
+		t.Fatalf("codes lost: %v", res.Failures())
+	}
+
+	// The returned result does not alias the builder.
+	b.AddError("later")
+	if len(res.Message) != 4 {
+		t.Fatalf("result changed after further additions: %v", res.Message)
+	}
+	if got := len(b.Result().Message); got != 5 {
+		t.Fatalf("builder has %d messages want 5", got)
+	}
+}
+
+func TestResultBuilderFailureWithoutMessages(t *testing.T) {
+	t.Parallel()
+	failNoMsg := ValidatorFunc(func() ValidationResult { return Fail() })
+
+	var b ResultBuilder
+	b.Require(NonEmpty("x")).Require(failNoMsg)
+	if res := b.Result(); res.IsValid || len(res.Message) != 0 {
+		t.Fatalf("result=%+v want invalid without messages", res)
+	}
+
+	var at ResultBuilder
+	at.addAt("field", failNoMsg.Validate())
+	if res := at.Result(); res.IsValid {
+		t.Fatalf("addAt result=%+v want invalid", res)
+	}
+}
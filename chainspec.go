@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// RuleSpec is the JSON form of one chain step: a registered rule name, the
+// operator joining it to the chain ("and", "or" or "xor"; empty means "and")
+// and the rule's arguments as passed to BuildRule. An argument equal to
+// ValueArg stands for the value under test.
+type RuleSpec struct {
+	Rule string   `json:"rule"`
+	Op   string   `json:"op,omitempty"`
+	Args []string `json:"args,omitempty"`
+}
+
+// ValueArg is the RuleSpec argument that Chain.Bind replaces with the value
+// being validated, so a spec can be shipped as config and reused for any
+// input.
+const ValueArg = "$value"
+
+var specOps = map[string]logicalOp{"": opAnd, "and": opAnd, "or": opOr, "xor": opXor}
+
+// Chain is a validation chain defined by rule specs, not yet bound to a
+// value. It round-trips through JSON as an array of RuleSpec objects.
+type Chain struct {
+	specs []RuleSpec
+}
+
+// ParseChain parses a JSON array of RuleSpec objects, e.g.
+//
+//	[{"rule":"non_empty","args":["$value"]},{"rule":"min_len","op":"or","args":["$value","3"]}]
+//
+// Rules are looked up in the registry (see RegisterRule). Unknown rules and
+// operators are reported with the index of the step; bad arguments are
+// reported by Bind.
+func ParseChain(jsonSpec []byte) (*Chain, error) {
+	c := new(Chain)
+	if err := c.UnmarshalJSON(jsonSpec); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Bind builds the chain for value, replacing every ValueArg argument with
+// it. The spec itself never contains the input.
+func (c *Chain) Bind(value string) (*FluentValidator, error) {
+	f := &FluentValidator{steps: make([]chainedStep, 0, len(c.specs))}
+	for i, spec := range c.specs {
+		args := make([]string, len(spec.Args))
+		for j, a := range spec.Args {
+			if a == ValueArg {
+				a = value
+			}
+			args[j] = a
+		}
+		v, err := buildRule(spec.Rule, args)
+		if err != nil {
+			return nil, fmt.Errorf("validate: chain[%d]: %w", i, err)
+		}
+		f.steps = append(f.steps, chainedStep{validator: v, op: specOps[spec.Op]})
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes the chain as its array of rule specs, with explicit
+// operators.
+func (c Chain) MarshalJSON() ([]byte, error) {
+	specs := c.specs
+	if specs == nil {
+		specs = []RuleSpec{}
+	}
+	return json.Marshal(specs)
+}
+
+// UnmarshalJSON decodes and checks an array of rule specs like ParseChain.
+func (c *Chain) UnmarshalJSON(b []byte) error {
+	var specs []RuleSpec
+	if err := json.Unmarshal(b, &specs); err != nil {
+		return fmt.Errorf("validate: parse chain: %w", err)
+	}
+	for i := range specs {
+		op, ok := specOps[specs[i].Op]
+		if !ok {
+			return errors.New("validate: chain[" + strconv.Itoa(i) + "]: unknown op " + strconv.Quote(specs[i].Op))
+		}
+		specs[i].Op = op.String()
+		ruleRegistry.RLock()
+		_, ok = ruleRegistry.m[specs[i].Rule]
+		ruleRegistry.RUnlock()
+		if !ok {
+			return errors.New("validate: chain[" + strconv.Itoa(i) + "]: unknown rule " + strconv.Quote(specs[i].Rule))
+		}
+	}
+	c.specs = specs
+	return nil
+}
@@ -0,0 +1,119 @@
+package validate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseChain(t *testing.T) {
+	t.Parallel()
+	// (non_empty AND min_len 3) OR email
+	c, err := ParseChain([]byte(`[
+		{"rule": "non_empty", "args": ["$value"]},
+		{"rule": "min_len", "args": ["$value", "3"]},
+		{"rule": "email", "op": "or", "args": ["$value"]}
+	]`))
+	if err != nil {
+		t.Fatalf("ParseChain: %v", err)
+	}
+	tests := []struct {
+		name      string
+		input     string
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"AND branch passes", "abcd", true, nil},
+		{"OR branch passes", "a@b.co", true, nil},
+		{"both fail", "ab", false, []string{"too short: min 3", "invalid email"}},
+		{"JSON metacharacters in input", `a"b\`, true, nil},
+		{"placeholder as input", "$value", true, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := c.Bind(tc.input)
+			if err != nil {
+				t.Fatalf("Bind: %v", err)
+			}
+			res := f.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestParseChainErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{"unknown rule", `[{"rule":"non_empty","args":["$value"]},{"rule":"nope"}]`, `validate: chain[1]: unknown rule "nope"`},
+		{"unknown op", `[{"rule":"non_empty","op":"nand","args":["$value"]}]`, `validate: chain[0]: unknown op "nand"`},
+		{"not an array", `{"rule":"non_empty"}`, `validate: parse chain: json: cannot unmarshal object into Go value of type []validate.RuleSpec`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseChain([]byte(tc.spec))
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("err=%v want %s", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestChainBindErrors(t *testing.T) {
+	t.Parallel()
+	c, err := ParseChain([]byte(`[{"rule":"non_empty","args":["$value"]},{"rule":"int_min","args":["$value","1"]}]`))
+	if err != nil {
+		t.Fatalf("ParseChain: %v", err)
+	}
+	if _, err := c.Bind("7"); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	want := `validate: chain[1]: rule "int_min": invalid int arg "seven"`
+	if _, err := c.Bind("seven"); err == nil || err.Error() != want {
+		t.Fatalf("err=%v want %s", err, want)
+	}
+}
+
+func TestChainJSON(t *testing.T) {
+	t.Parallel()
+	in := `[{"rule":"non_empty","args":["$value"]},{"rule":"int_between","op":"xor","args":["$value","1","5"]}]`
+	c, err := ParseChain([]byte(in))
+	if err != nil {
+		t.Fatalf("ParseChain: %v", err)
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	want := `[{"rule":"non_empty","op":"and","args":["$value"]},{"rule":"int_between","op":"xor","args":["$value","1","5"]}]`
+	if string(b) != want {
+		t.Fatalf("json=%s want %s", b, want)
+	}
+
+	// A Chain can be embedded in configuration structs.
+	var cfg struct {
+		Name Chain `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(`{"name":`+want+`}`), &cfg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if b, _ := json.Marshal(cfg); string(b) != `{"name":`+want+`}` {
+		t.Fatalf("json=%s want the same chain", b)
+	}
+	if err := json.Unmarshal([]byte(`{"name":[{"rule":"nope"}]}`), &cfg); err == nil {
+		t.Fatalf("unmarshal of an unknown rule should fail")
+	}
+
+	// Chains built in Go are plain values to encoding/json, as before.
+	if _, err := json.Marshal(New().And(NonEmpty("x"))); err != nil {
+		t.Fatalf("marshal of a Go-built chain: %v", err)
+	}
+}
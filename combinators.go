@@ -0,0 +1,225 @@
+package validate
+
+import (
+	"strconv"
+	"sync"
+)
+
+// When runs v only if cond is true; otherwise it succeeds without a message,
+// so a skipped validator contributes nothing to a chain.
+func When(cond bool, v Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if !cond {
+			return Success()
+		}
+		return v.Validate()
+	})
+}
+
+// Unless runs v only if cond is false.
+func Unless(cond bool, v Validator) Validator {
+	return When(!cond, v)
+}
+
+// WhenFunc is like When but evaluates cond lazily, at validation time.
+func WhenFunc(cond func() bool, v Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if !cond() {
+			return Success()
+		}
+		return v.Validate()
+	})
+}
+
+// Predicate turns a boolean into a validator that fails with failMsg when ok
+// is false, for quick custom checks in a chain:
+//
+//	New().And(Predicate(user.Active, "user must be active"))
+func Predicate(ok bool, failMsg string) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if !ok {
+			return Fail(failMsg)
+		}
+		return Success()
+	})
+}
+
+// PredicateFunc is like Predicate but calls fn at validation time.
+func PredicateFunc(fn func() bool, failMsg string) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if !fn() {
+			return Fail(failMsg)
+		}
+		return Success()
+	})
+}
+
+// All runs every validator and fails with the failures of all that fail.
+// Unlike an AND chain it does not stop at the first failure.
+func All(validators ...Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		var messages []string
+		var failures []failure
+		valid := true
+		for _, v := range validators {
+			if res := v.Validate(); !res.IsValid {
+				valid = false
+				messages, failures = appendFailures(messages, failures, res)
+			}
+		}
+		if valid {
+			return Success()
+		}
+		if messages == nil {
+			messages = emptyMessages
+		}
+		return ValidationResult{IsValid: false, Message: messages, failures: failures}
+	})
+}
+
+// Any passes as soon as one validator passes, like an OR chain; when none
+// pass it fails with all of their failures.
+func Any(validators ...Validator) Validator {
+	f := &FluentValidator{steps: make([]chainedStep, 0, len(validators))}
+	for _, v := range validators {
+		f.Or(v)
+	}
+	return f
+}
+
+// NoneOf passes only when every validator fails, e.g. to reject input that
+// matches any of several forbidden patterns. It stops at the first
+// validator that passes and reports its 1-based position.
+func NoneOf(validators ...Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		for i, v := range validators {
+			if v.Validate().IsValid {
+				return FailCode("value.forbidden", "matches forbidden condition "+strconv.Itoa(i+1), "index", i+1)
+			}
+		}
+		return Success()
+	})
+}
+
+// Memoize runs v at most once and returns the cached result on every later
+// call, which avoids repeating expensive checks (large regexes, lookups)
+// when a validator is evaluated several times. It assumes the value v
+// validates is fixed for the validator's lifetime. It is safe for
+// concurrent use.
+func Memoize(v Validator) Validator {
+	m := &memoized{v: v}
+	return ValidatorFunc(func() ValidationResult {
+		m.once.Do(func() { m.res = m.v.Validate() })
+		return m.res
+	})
+}
+
+type memoized struct {
+	once sync.Once
+	v    Validator
+	res  ValidationResult
+}
+
+// EachStruct validates every element of items with the validator build
+// returns for it, typically a chain over the element's fields. Failures of
+// all elements are collected, each prefixed with its index as in
+// "[2]: sku: must not be empty"; the result is valid only when every
+// element is.
+func EachStruct[T any](items []T, build func(i int, item T) Validator) ValidationResult {
+	var b ResultBuilder
+	for i, item := range items {
+		b.Require(Named("["+strconv.Itoa(i)+"]", build(i, item)))
+	}
+	return b.Result()
+}
+
+// Optional runs v only when s is non-empty, so an absent optional field passes.
+func Optional(s string, v Validator) Validator {
+	return When(s != "", v)
+}
+
+// OptionalPtr runs rule on the pointee only when p is non-nil.
+func OptionalPtr[T any](p *T, rule func(T) Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if p == nil {
+			return Success()
+		}
+		return rule(*p).Validate()
+	})
+}
+
+// Normalized runs rule on transform(s), so checks see a normalized form of
+// the input (trimmed, lowercased, ...) while the caller keeps the original.
+// Any func(string) string works as a transform, e.g. strings.TrimSpace;
+// combine several with Transforms.
+func Normalized(s string, transform func(string) string, rule func(string) Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		return rule(transform(s)).Validate()
+	})
+}
+
+// Transforms composes string transforms, applying them left to right.
+func Transforms(fns ...func(string) string) func(string) string {
+	return func(s string) string {
+		for _, fn := range fns {
+			s = fn(s)
+		}
+		return s
+	}
+}
+
+// NotNilPtr validates that p is not nil.
+func NotNilPtr[T any](p *T) ValidatorFunc {
+	return func() ValidationResult {
+		if p == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		return Success()
+	}
+}
+
+// DerefThen fails when p is nil and otherwise runs rule on the pointee.
+// Unlike OptionalPtr, a nil pointer is an error.
+func DerefThen[T any](p *T, rule func(T) Validator) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if p == nil {
+			return FailCode("value.not_nil", "must not be nil")
+		}
+		return rule(*p).Validate()
+	})
+}
+
+// NamedValidator wraps a Validator with the name of the value it checks.
+// Its failure messages are prefixed with "name: ", so errors stay
+// self-describing when several steps are combined in one chain.
+type NamedValidator struct {
+	Name      string
+	Validator Validator
+}
+
+// Named returns v wrapped with name. Nesting Named wrappers builds a path:
+// Named("address", Named("city", v)) reports "address: city: ...".
+func Named(name string, v Validator) NamedValidator {
+	return NamedValidator{Name: name, Validator: v}
+}
+
+// Validate runs the wrapped validator and prefixes its failure messages.
+func (n NamedValidator) Validate() ValidationResult {
+	res := n.Validator.Validate()
+	if res.IsValid || len(res.Message) == 0 {
+		return res
+	}
+	messages := make([]string, len(res.Message))
+	failures := make([]failure, len(res.Message))
+	for i, m := range res.Message {
+		fl := res.failureAt(i)
+		if fl.field == "" {
+			fl.field = n.Name
+		} else {
+			fl.field = n.Name + "." + fl.field
+		}
+		messages[i] = n.Name + ": " + m
+		failures[i] = fl
+	}
+	return ValidationResult{IsValid: false, Message: messages, failures: failures}
+}
@@ -0,0 +1,320 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConditionalCombinators(t *testing.T) {
+	t.Parallel()
+	country := "US"
+	lazyCalls := 0
+	isUS := func() bool {
+		lazyCalls++
+		return country == "US"
+	}
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"When true runs", When(country == "US", NonEmpty("")), false, []string{"must not be empty"}},
+		{"When false skips", When(country == "CA", NonEmpty("")), true, []string{}},
+		{"Unless false runs", Unless(country == "CA", NonEmpty("")), false, []string{"must not be empty"}},
+		{"Unless true skips", Unless(country == "US", NonEmpty("")), true, []string{}},
+		{"WhenFunc runs", WhenFunc(isUS, MinLen("N", 2)), false, []string{"too short: min 2"}},
+		{"When skipped in chain", New().And(NonEmpty("x")).And(When(false, NonEmpty(""))), true, []string{}},
+		{"When failing in chain", New().And(NonEmpty("x")).And(When(true, NonEmpty(""))), false, []string{"must not be empty"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+	if lazyCalls != 1 {
+		t.Fatalf("WhenFunc condition evaluated %d times, want 1", lazyCalls)
+	}
+}
+
+func TestAllAny(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	counted := func(v Validator) Validator {
+		return ValidatorFunc(func() ValidationResult {
+			calls++
+			return v.Validate()
+		})
+	}
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"All empty passes", All(), true, []string{}},
+		{"All passes", All(NonEmpty("x"), MinLen("abc", 2)), true, []string{}},
+		{"All aggregates every failure", All(NonEmpty(""), MinLen("a", 2), NonEmpty("x"), IntMin(1, 2)), false, []string{"must not be empty", "too short: min 2", "must be >= 2"}},
+		{"All fails on a failure without messages", All(NonEmpty("x"), ValidatorFunc(func() ValidationResult { return Fail() })), false, []string{}},
+		{"Any passes on one", Any(NonEmpty(""), NonEmpty("x")), true, []string{}},
+		{"Any fails with all", Any(NonEmpty(""), MinLen("a", 2)), false, []string{"must not be empty", "too short: min 2"}},
+		{"All inside chain", New().And(NonEmpty("x")).And(All(NonEmpty(""), MinLen("", 1))), false, []string{"must not be empty", "too short: min 1"}},
+		{"Any inside All", All(Any(NonEmpty(""), NonEmpty("x")), MinLen("a", 2)), false, []string{"too short: min 2"}},
+		{"NoneOf empty passes", NoneOf(), true, []string{}},
+		{"NoneOf all fail passes", NoneOf(MatchesString("hello", "^admin"), MatchesString("hello", "drop table")), true, []string{}},
+		{"NoneOf one passes fails", NoneOf(MatchesString("admin1", "drop table"), MatchesString("admin1", "^admin")), false, []string{"matches forbidden condition 2"}},
+		{"NoneOf inside chain", New().And(NonEmpty("x")).And(NoneOf(NonEmpty("x"))), false, []string{"matches forbidden condition 1"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+
+	// Any stops at the first passing validator.
+	if res := Any(counted(NonEmpty("x")), counted(NonEmpty(""))).Validate(); !res.IsValid || calls != 1 {
+		t.Fatalf("Any: valid=%v calls=%d want true, 1", res.IsValid, calls)
+	}
+	if res := All(NonEmpty(""), IntMin(1, 2)).Validate(); !res.HasCode("number.min") {
+		t.Fatalf("All dropped failure codes: %v", res.Failures())
+	}
+}
+
+func TestOptional(t *testing.T) {
+	t.Parallel()
+	age := 12
+	minAge := func(v int) Validator { return IntMin(v, 18) }
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"Optional empty passes", Optional("", EmailValid("")), true, []string{}},
+		{"Optional valid passes", Optional("a@b.co", EmailValid("a@b.co")), true, nil},
+		{"Optional invalid fails", Optional("nope", EmailValid("nope")), false, []string{"invalid email"}},
+		{"OptionalPtr nil passes", OptionalPtr(nil, minAge), true, []string{}},
+		{"OptionalPtr invalid fails", OptionalPtr(&age, minAge), false, []string{"must be >= 18"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	t.Parallel()
+	nonEmpty := func(s string) Validator { return NonEmpty(s) }
+	email := func(s string) Validator { return EmailDomainAllowlist(s, []string{"example.com"}) }
+	trimLower := Transforms(strings.TrimSpace, strings.ToLower)
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"trim then NonEmpty fails", Normalized("   ", strings.TrimSpace, nonEmpty), false, []string{"must not be empty"}},
+		{"trim then NonEmpty passes", Normalized("  x ", strings.TrimSpace, nonEmpty), true, nil},
+		{"without trim passes", NonEmpty("   "), true, nil},
+		{"composed transforms", Normalized(" Ada@EXAMPLE.com ", trimLower, func(s string) Validator { return OneOf(s, []string{"ada@example.com"}, true) }), true, nil},
+		{"composed transforms fail", Normalized(" ada@other.com", trimLower, email), false, []string{"email domain not allowed"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestPointerRules(t *testing.T) {
+	t.Parallel()
+	type address struct{ City string }
+	name := "Ada"
+	empty := ""
+	addr := &address{City: ""}
+	cityRule := func(a address) Validator { return NonEmpty(a.City) }
+	nameRule := func(s string) Validator { return MinLen(s, 2) }
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"NotNilPtr scalar ok", NotNilPtr(&name), true, nil},
+		{"NotNilPtr scalar nil", NotNilPtr[string](nil), false, []string{"must not be nil"}},
+		{"NotNilPtr struct ok", NotNilPtr(addr), true, nil},
+		{"NotNilPtr struct nil", NotNilPtr[address](nil), false, []string{"must not be nil"}},
+		{"DerefThen scalar ok", DerefThen(&name, nameRule), true, nil},
+		{"DerefThen scalar invalid", DerefThen(&empty, nameRule), false, []string{"too short: min 2"}},
+		{"DerefThen scalar nil", DerefThen(nil, nameRule), false, []string{"must not be nil"}},
+		{"DerefThen struct invalid", DerefThen(addr, cityRule), false, []string{"must not be empty"}},
+		{"DerefThen struct nil", DerefThen(nil, cityRule), false, []string{"must not be nil"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestNamed(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"passes", Named("email", EmailValid("a@b.co")), true, nil},
+		{"prefixes message", Named("email", EmailValid("nope")), false, []string{"email: invalid email"}},
+		{"prefixes uncoded message", Named("note", ValidatorFunc(func() ValidationResult { return Fail("bad") })), false, []string{"note: bad"}},
+		{"nested", Named("address", Named("city", NonEmpty(""))), false, []string{"address: city: must not be empty"}},
+		{
+			"mixed in chain",
+			New().Or(Named("email", EmailValid("nope"))).Or(PhoneE164("123")).Or(Named("age", IntMin(1, 18))),
+			false,
+			[]string{"email: invalid email", "invalid phone (use E.164, e.g. +15551234567)", "age: must be >= 18"},
+		},
+		{"group of named", Named("contact", New().Or(Named("email", EmailValid("x"))).Or(NonEmpty(""))), false, []string{"contact: email: invalid email", "contact: must not be empty"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+
+	res := New().And(Named("address", Named("city", MinLen("N", 2)))).Validate()
+	got := res.Failures()
+	if len(got) != 1 || got[0].Field != "address.city" || got[0].Code != "string.min_len" {
+		t.Fatalf("failures=%#v", got)
+	}
+	es := Catalog{"string.min_len": func(args ...any) string { return "demasiado corto" }}
+	if loc := Localize(res, es); !reflect.DeepEqual(loc.Message, []string{"address: city: demasiado corto"}) {
+		t.Fatalf("localized=%v", loc.Message)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	v := Memoize(ValidatorFunc(func() ValidationResult {
+		calls++
+		return FailCode("string.min_len", "too short: min 3", "min", 3)
+	}))
+	for i := 0; i < 3; i++ {
+		res := v.Validate()
+		if res.IsValid || !res.HasCode("string.min_len") {
+			t.Fatalf("call %d: got %+v", i, res)
+		}
+	}
+	New().And(v).And(v).Validate()
+	if calls != 1 {
+		t.Fatalf("inner validator ran %d times, want 1", calls)
+	}
+}
+
+func TestEachStruct(t *testing.T) {
+	t.Parallel()
+	type lineItem struct {
+		SKU string
+		Qty int
+	}
+	rules := func(i int, it lineItem) Validator {
+		return New().
+			And(Named("sku", NonEmpty(it.SKU))).
+			And(Named("qty", IntBetween(it.Qty, 1, 100)))
+	}
+	items := []lineItem{{"", 1}, {"A-1", 5}, {"B-2", 0}}
+	res := EachStruct(items, rules)
+	want := []string{"[0]: sku: must not be empty", "[2]: qty: must be between 1 and 100"}
+	if res.IsValid || !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("got valid=%v msgs=%q, want %q", res.IsValid, res.Message, want)
+	}
+	var fields []string
+	for _, f := range res.Failures() {
+		fields = append(fields, f.Field)
+	}
+	if wantFields := []string{"[0].sku", "[2].qty"}; !reflect.DeepEqual(fields, wantFields) {
+		t.Fatalf("fields=%q want %q", fields, wantFields)
+	}
+	if res := EachStruct(items[1:2], rules); !res.IsValid {
+		t.Fatalf("valid element failed: %v", res.Message)
+	}
+	if res := EachStruct(nil, rules); !res.IsValid {
+		t.Fatalf("empty slice failed: %v", res.Message)
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	active := func() bool { calls++; return false }
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"Predicate true", Predicate(true, "user must be active"), true, []string{}},
+		{"Predicate false", Predicate(false, "user must be active"), false, []string{"user must be active"}},
+		{"PredicateFunc true", PredicateFunc(func() bool { return true }, "nope"), true, []string{}},
+		{"PredicateFunc false", PredicateFunc(active, "user must be active"), false, []string{"user must be active"}},
+		{"Predicate in chain", New().And(NonEmpty("x")).And(Predicate(false, "user must be active")), false, []string{"user must be active"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if !tc.wantValid && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+	// PredicateFunc is evaluated lazily, on every Validate.
+	v := PredicateFunc(active, "x")
+	v.Validate()
+	v.Validate()
+	if calls != 3 {
+		t.Fatalf("PredicateFunc ran %d times, want 3", calls)
+	}
+}
@@ -0,0 +1,89 @@
+package validate
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // optional symbolic values, names[i] == min+i
+}
+
+var (
+	cronSeconds = cronField{name: "second", min: 0, max: 59}
+	cronFields  = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+		// 7 is accepted as an alias for Sunday.
+		{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	}
+)
+
+// IsCron validates a standard 5-field cron expression (minute hour
+// day-of-month month day-of-week), or a 6-field one with a leading seconds
+// field when withSeconds is true. Each field accepts *, values, ranges
+// (a-b), steps (*/n, a-b/n, a/n) and comma-separated lists; months and
+// weekdays also accept three-letter names such as JAN or MON.
+func IsCron(s string, withSeconds bool) ValidatorFunc {
+	return func() ValidationResult {
+		fields := cronFields
+		if withSeconds {
+			fields = append([]cronField{cronSeconds}, cronFields...)
+		}
+		parts := strings.Fields(s)
+		if len(parts) != len(fields) {
+			return FailCode("cron.fields", "cron expression must have "+strconv.Itoa(len(fields))+" fields", "count", len(fields))
+		}
+		for i, f := range fields {
+			if !f.valid(parts[i]) {
+				return FailCode("cron.field", "invalid cron "+f.name+" field", "field", f.name)
+			}
+		}
+		return Success()
+	}
+}
+
+func (f cronField) valid(s string) bool {
+	for _, item := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 || n > f.max {
+				return false
+			}
+		}
+		if rng == "*" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(rng, "-")
+		a, ok := f.value(lo)
+		if !ok {
+			return false
+		}
+		if isRange {
+			b, ok := f.value(hi)
+			if !ok || b < a {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (f cronField) value(s string) (int, bool) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, true
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max || s[0] == '+' || s[0] == '-' {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,45 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsCron(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"every 15 minutes", IsCron("*/15 * * * *", false), true, nil},
+		{"named weekday", IsCron("0 0 1 * MON", false), true, nil},
+		{"ranges and lists", IsCron("0,30 9-17 * JAN-jun mon-fri", false), true, nil},
+		{"range with step", IsCron("0-30/5 * * * *", false), true, nil},
+		{"sunday as 7", IsCron("0 0 * * 7", false), true, nil},
+		{"with seconds", IsCron("30 */5 * * * *", true), true, nil},
+		{"minute out of range", IsCron("60 * * * *", false), false, []string{"invalid cron minute field"}},
+		{"hour out of range", IsCron("0 24 * * *", false), false, []string{"invalid cron hour field"}},
+		{"day of month zero", IsCron("0 0 0 * *", false), false, []string{"invalid cron day of month field"}},
+		{"bad month name", IsCron("0 0 1 FOO *", false), false, []string{"invalid cron month field"}},
+		{"reversed range", IsCron("0 0 * * 5-1", false), false, []string{"invalid cron day of week field"}},
+		{"zero step", IsCron("*/0 * * * *", false), false, []string{"invalid cron minute field"}},
+		{"empty list item", IsCron("1,,2 * * * *", false), false, []string{"invalid cron minute field"}},
+		{"second out of range", IsCron("60 0 * * * *", true), false, []string{"invalid cron second field"}},
+		{"too few fields", IsCron("* * * *", false), false, []string{"cron expression must have 5 fields"}},
+		{"seconds not enabled", IsCron("0 * * * * *", false), false, []string{"cron expression must have 5 fields"}},
+		{"seconds missing", IsCron("* * * * *", true), false, []string{"cron expression must have 6 fields"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package validate
+
+import (
+	"cmp"
+	"time"
+)
+
+// Cross-field rules compare two values of a form or struct. When two names
+// are given they appear in the message, e.g. "password and confirm must
+// match"; otherwise a generic message is used.
+
+func fieldNames(names []string) (string, string, bool) {
+	if len(names) < 2 {
+		return "", "", false
+	}
+	return names[0], names[1], true
+}
+
+func orderFail(names []string, rel string) ValidationResult {
+	if a, b, ok := fieldNames(names); ok {
+		return FailCode("field.order", a+" must be "+rel+" "+b, "fields", names[:2])
+	}
+	return FailCode("field.order", "fields must be in order")
+}
+
+// FieldsEqual validates that a == b, e.g. a password and its confirmation.
+func FieldsEqual[T comparable](a, b T, names ...string) ValidatorFunc {
+	return func() ValidationResult {
+		if a == b {
+			return Success()
+		}
+		if x, y, ok := fieldNames(names); ok {
+			return FailCode("field.equal", x+" and "+y+" must match", "fields", names[:2])
+		}
+		return FailCode("field.equal", "fields must match")
+	}
+}
+
+// FieldLess validates that a < b.
+func FieldLess[T cmp.Ordered](a, b T, names ...string) ValidatorFunc {
+	return func() ValidationResult {
+		if cmp.Less(a, b) {
+			return Success()
+		}
+		return orderFail(names, "less than")
+	}
+}
+
+// FieldLessEqual validates that a <= b.
+func FieldLessEqual[T cmp.Ordered](a, b T, names ...string) ValidatorFunc {
+	return func() ValidationResult {
+		if cmp.Compare(a, b) <= 0 {
+			return Success()
+		}
+		return orderFail(names, "less than or equal to")
+	}
+}
+
+// FieldBefore validates that time a is strictly before b, e.g. a start
+// date before an end date.
+func FieldBefore(a, b time.Time, names ...string) ValidatorFunc {
+	return func() ValidationResult {
+		if a.Before(b) {
+			return Success()
+		}
+		return orderFail(names, "before")
+	}
+}
+
+// FieldBeforeOrEqual validates that time a is not after b.
+func FieldBeforeOrEqual(a, b time.Time, names ...string) ValidatorFunc {
+	return func() ValidationResult {
+		if !a.After(b) {
+			return Success()
+		}
+		return orderFail(names, "before or equal to")
+	}
+}
+
+// RequireIf fails with "is required" when condition is true and value is
+// empty, e.g. RequireIf(country == "US", state) for a dependent form field.
+func RequireIf(condition bool, value string) Validator {
+	return ValidatorFunc(func() ValidationResult {
+		if condition && value == "" {
+			return FailCode("field.required", "is required")
+		}
+		return Success()
+	})
+}
+
+// RequireUnless fails with "is required" when condition is false and value
+// is empty.
+func RequireUnless(condition bool, value string) Validator {
+	return RequireIf(!condition, value)
+}
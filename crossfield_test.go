@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCrossFieldRules(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"FieldsEqual strings ok", FieldsEqual("s3cret", "s3cret"), true, nil},
+		{"FieldsEqual strings fail", FieldsEqual("s3cret", "s3cr3t"), false, []string{"fields must match"}},
+		{"FieldsEqual named", FieldsEqual("s3cret", "", "password", "confirm"), false, []string{"password and confirm must match"}},
+		{"FieldsEqual ints", FieldsEqual(3, 3), true, nil},
+		{"FieldsEqual times", FieldsEqual(start, start), true, nil},
+		{"FieldLess ints ok", FieldLess(1, 2), true, nil},
+		{"FieldLess ints equal", FieldLess(2, 2), false, []string{"fields must be in order"}},
+		{"FieldLess strings named", FieldLess("b", "a", "min", "max"), false, []string{"min must be less than max"}},
+		{"FieldLessEqual equal", FieldLessEqual(2.5, 2.5), true, nil},
+		{"FieldLessEqual fail", FieldLessEqual(3, 2, "min", "max"), false, []string{"min must be less than or equal to max"}},
+		{"FieldBefore ok", FieldBefore(start, end), true, nil},
+		{"FieldBefore equal", FieldBefore(start, start, "start", "end"), false, []string{"start must be before end"}},
+		{"FieldBefore reversed", FieldBefore(end, start), false, []string{"fields must be in order"}},
+		{"FieldBeforeOrEqual equal", FieldBeforeOrEqual(start, start), true, nil},
+		{"FieldBeforeOrEqual fail", FieldBeforeOrEqual(end, start, "start", "end"), false, []string{"start must be before or equal to end"}},
+		{"one name falls back", FieldLess(2, 1, "min"), false, []string{"fields must be in order"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestRequireIf(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"RequireIf true empty", RequireIf(true, ""), false, []string{"is required"}},
+		{"RequireIf true set", RequireIf(true, "CA"), true, nil},
+		{"RequireIf false empty", RequireIf(false, ""), true, nil},
+		{"RequireIf false set", RequireIf(false, "CA"), true, nil},
+		{"RequireUnless true empty", RequireUnless(true, ""), true, nil},
+		{"RequireUnless true set", RequireUnless(true, "CA"), true, nil},
+		{"RequireUnless false empty", RequireUnless(false, ""), false, []string{"is required"}},
+		{"RequireUnless false set", RequireUnless(false, "CA"), true, nil},
+		{"named in chain", New().And(Named("state", RequireIf(true, ""))), false, []string{"state: is required"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package validate
+
+import "strings"
+
+// EmailPolicy describes how the local part of addresses at a domain is
+// canonicalized by NormalizeEmail.
+type EmailPolicy struct {
+	LowercaseLocal  bool // the provider treats the local part case-insensitively
+	StripSubaddress bool // drop "+tag" sub-addressing
+	IgnoreDots      bool // dots in the local part are insignificant
+}
+
+// GmailPolicy returns the canonicalization applied by Gmail.
+func GmailPolicy() EmailPolicy {
+	return EmailPolicy{LowercaseLocal: true, StripSubaddress: true, IgnoreDots: true}
+}
+
+// NormalizeEmail returns the canonical form of the email address s for
+// deduplication. Only the domain is lowercased; use NormalizeEmailWith to
+// canonicalize local parts as well. On failure the returned string is empty.
+func NormalizeEmail(s string) (string, ValidationResult) {
+	return NormalizeEmailWith(s, nil)
+}
+
+// NormalizeEmailWith is like NormalizeEmail but also applies policies, which
+// maps lowercase domains to their EmailPolicy; the key "*" applies to every
+// other domain, e.g.
+//
+//	validate.NormalizeEmailWith(s, map[string]validate.EmailPolicy{"gmail.com": validate.GmailPolicy()})
+func NormalizeEmailWith(s string, policies map[string]EmailPolicy) (string, ValidationResult) {
+	s = strings.TrimSpace(s)
+	if res := EmailValid(s)(); !res.IsValid {
+		return "", res
+	}
+	at := strings.LastIndexByte(s, '@')
+	local, domain := s[:at], strings.ToLower(s[at+1:])
+	policy, ok := policies[domain]
+	if !ok {
+		policy = policies["*"]
+	}
+	if policy.StripSubaddress {
+		if i := strings.IndexByte(local, '+'); i > 0 {
+			local = local[:i]
+		}
+	}
+	if policy.IgnoreDots {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	if policy.LowercaseLocal {
+		local = strings.ToLower(local)
+	}
+	if local == "" {
+		return "", FailCode("email.invalid", "invalid email")
+	}
+	return local + "@" + domain, Success()
+}
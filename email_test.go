@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	t.Parallel()
+	gmail := map[string]EmailPolicy{"gmail.com": GmailPolicy()}
+	lower := map[string]EmailPolicy{"*": {LowercaseLocal: true}}
+	tests := []struct {
+		name      string
+		in        string
+		policies  map[string]EmailPolicy
+		want      string
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"gmail policy", "User+tag@Gmail.com", gmail, "user@gmail.com", true, nil},
+		{"gmail dots", " first.last@gmail.com ", gmail, "firstlast@gmail.com", true, nil},
+		{"no policy keeps local part", "User+tag@Gmail.com", nil, "User+tag@gmail.com", true, nil},
+		{"policy is per domain", "User+tag@Example.com", gmail, "User+tag@example.com", true, nil},
+		{"wildcard policy", "User+tag@Example.com", lower, "user+tag@example.com", true, nil},
+		{"leading plus kept", "+tag@gmail.com", gmail, "+tag@gmail.com", true, nil},
+		{"only dots", "...@gmail.com", gmail, "", false, []string{"invalid email"}},
+		{"invalid", "user@", gmail, "", false, []string{"invalid email"}},
+		{"empty", "", gmail, "", false, []string{"must not be empty"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, res := NormalizeEmailWith(tc.in, tc.policies)
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+			if got != tc.want {
+				t.Fatalf("got=%q want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmailWithoutPolicies(t *testing.T) {
+	t.Parallel()
+	if got, _ := NormalizeEmail("User+tag@Gmail.com"); got != "User+tag@gmail.com" {
+		t.Fatalf("got=%q want only the domain lowercased", got)
+	}
+}
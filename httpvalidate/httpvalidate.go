@@ -0,0 +1,53 @@
+// Package httpvalidate provides net/http middleware that decodes and
+// validates JSON request bodies with validate chains.
+package httpvalidate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"validate"
+)
+
+type bodyKey[T any] struct{}
+
+// ValidateJSON returns middleware that decodes the JSON request body into a
+// fresh *T and validates it with the chain returned by rules. On a decode or
+// validation failure it writes a 400 response whose body is the
+// validate.NewJSONError output and does not call next. On success the
+// decoded value is stored in the request context; retrieve it with Body.
+func ValidateJSON[T any](rules func(*T) *validate.FluentValidator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			target := new(T)
+			if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+				writeError(w, []string{"invalid JSON body"})
+				return
+			}
+			if res := rules(target).Validate(); !res.IsValid {
+				writeError(w, res.Message)
+				return
+			}
+			ctx := context.WithValue(r.Context(), bodyKey[T]{}, target)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Body returns the value decoded by ValidateJSON[T] for this context.
+func Body[T any](ctx context.Context) (*T, bool) {
+	v, ok := ctx.Value(bodyKey[T]{}).(*T)
+	return v, ok
+}
+
+func writeError(w http.ResponseWriter, msgs []string) {
+	if len(msgs) == 0 {
+		msgs = []string{"validation failed"}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	if err := validate.NewJSONError(msgs); err != nil {
+		_, _ = w.Write([]byte(err.Error()))
+	}
+}
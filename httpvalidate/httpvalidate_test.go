@@ -0,0 +1,59 @@
+package httpvalidate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"validate"
+)
+
+type signup struct {
+	Email string `json:"email"`
+	Age   int    `json:"age"`
+}
+
+func TestValidateJSON(t *testing.T) {
+	t.Parallel()
+	rules := func(s *signup) *validate.FluentValidator {
+		return validate.New().And(validate.EmailValid(s.Email)).And(validate.IntMin(s.Age, 18))
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, ok := Body[signup](r.Context())
+		if !ok {
+			t.Errorf("decoded body missing from context")
+			return
+		}
+		_, _ = w.Write([]byte("hello " + s.Email))
+	})
+	h := ValidateJSON(rules)(next)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"valid", `{"email":"a@b.co","age":30}`, http.StatusOK, "hello a@b.co"},
+		{"invalid", `{"email":"nope","age":30}`, http.StatusBadRequest, `{"errors":["invalid email"]}`},
+		{"too young", `{"email":"a@b.co","age":12}`, http.StatusBadRequest, `{"errors":["must be \u003e= 18"]}`},
+		{"malformed", `{"email":`, http.StatusBadRequest, `{"errors":["invalid JSON body"]}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status=%d want %d", rec.Code, tc.wantStatus)
+			}
+			if got := rec.Body.String(); got != tc.wantBody {
+				t.Fatalf("body=%s want %s", got, tc.wantBody)
+			}
+			if tc.wantStatus == http.StatusBadRequest && rec.Header().Get("Content-Type") != "application/json" {
+				t.Fatalf("content-type=%q", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
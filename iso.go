@@ -0,0 +1,157 @@
+package validate
+
+import (
+	"strings"
+	"sync"
+)
+
+// Static ISO code tables, embedded so lookups need no network or files.
+const (
+	// ISO 3166-1 alpha-2
+	isoCountryAlpha2 = `AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ
+BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ
+DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP
+GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI
+KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP
+MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM
+PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX
+SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU
+WF WS YE YT ZA ZM ZW`
+
+	// ISO 3166-1 alpha-3
+	isoCountryAlpha3 = `AND ARE AFG ATG AIA ALB ARM AGO ATA ARG ASM AUT AUS ABW ALA AZE BIH BRB BGD
+BEL BFA BGR BHR BDI BEN BLM BMU BRN BOL BES BRA BHS BTN BVT BWA BLR BLZ CAN CCK COD CAF COG CHE
+CIV COK CHL CMR CHN COL CRI CUB CPV CUW CXR CYP CZE DEU DJI DNK DMA DOM DZA ECU EST EGY ESH ERI
+ESP ETH FIN FJI FLK FSM FRO FRA GAB GBR GRD GEO GUF GGY GHA GIB GRL GMB GIN GLP GNQ GRC SGS GTM
+GUM GNB GUY HKG HMD HND HRV HTI HUN IDN IRL ISR IMN IND IOT IRQ IRN ISL ITA JEY JAM JOR JPN KEN
+KGZ KHM KIR COM KNA PRK KOR KWT CYM KAZ LAO LBN LCA LIE LKA LBR LSO LTU LUX LVA LBY MAR MCO MDA
+MNE MAF MDG MHL MKD MLI MMR MNG MAC MNP MTQ MRT MSR MLT MUS MDV MWI MEX MYS MOZ NAM NCL NER NFK
+NGA NIC NLD NOR NPL NRU NIU NZL OMN PAN PER PYF PNG PHL PAK POL SPM PCN PRI PSE PRT PLW PRY QAT
+REU ROU SRB RUS RWA SAU SLB SYC SDN SWE SGP SHN SVN SJM SVK SLE SMR SEN SOM SUR SSD STP SLV SXM
+SYR SWZ TCA TCD ATF TGO THA TJK TKL TLS TKM TUN TON TUR TTO TUV TWN TZA UKR UGA UMI USA URY UZB
+VAT VCT VEN VGB VIR VNM VUT WLF WSM YEM MYT ZAF ZMB ZWE`
+
+	// ISO 4217 active codes, including funds and precious-metal codes
+	isoCurrency = `AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV
+BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE CZK DJF DKK DOP
+DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD HNL HTG HUF IDR ILS INR IQD IRR
+ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK
+MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG
+QAR RON RSD RUB RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL THB TJS TMT
+TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF XAG XAU XBA XBB
+XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW ZWG ZWL`
+
+	// ISO 639-1
+	isoLanguage = `aa ab ae af ak am an ar as av ay az ba be bg bi bm bn bo br bs ca ce ch co cr cs
+cu cv cy da de dv dz ee el en eo es et eu fa ff fi fj fo fr fy ga gd gl gn gu gv ha he hi ho hr
+ht hu hy hz ia id ie ig ii ik io is it iu ja jv ka kg ki kj kk kl km kn ko kr ks ku kv kw ky la
+lb lg li ln lo lt lu lv mg mh mi mk ml mn mr ms mt my na nb nd ne ng nl nn no nr nv ny oc oj om
+or os pa pi pl ps pt qu rm rn ro ru rw sa sc sd se sg si sk sl sm sn so sq sr ss st su sv sw ta
+te tg th ti tk tl tn to tr ts tt tw ty ug uk ur uz ve vi vo wa wo xh yi yo za zh zu`
+)
+
+var (
+	isoOnce                                                sync.Once
+	isoAlpha2Set, isoAlpha3Set, isoCurrencySet, isoLangSet map[string]struct{}
+)
+
+func codeSet(list string) map[string]struct{} {
+	fields := strings.Fields(list)
+	m := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		m[f] = struct{}{}
+	}
+	return m
+}
+
+func loadISO() {
+	isoOnce.Do(func() {
+		isoAlpha2Set = codeSet(isoCountryAlpha2)
+		isoAlpha3Set = codeSet(isoCountryAlpha3)
+		isoCurrencySet = codeSet(isoCurrency)
+		isoLangSet = codeSet(isoLanguage)
+	})
+}
+
+func inCodeSet(set map[string]struct{}, code string) bool {
+	_, ok := set[code]
+	return ok
+}
+
+// IsCountryCodeISO2 validates an ISO 3166-1 alpha-2 country code, case-insensitively.
+func IsCountryCodeISO2(s string) ValidatorFunc {
+	return func() ValidationResult {
+		loadISO()
+		if !inCodeSet(isoAlpha2Set, strings.ToUpper(s)) {
+			return FailCode("iso.country_alpha2", "must be an ISO 3166-1 alpha-2 country code")
+		}
+		return Success()
+	}
+}
+
+// IsCountryCodeISO3 validates an ISO 3166-1 alpha-3 country code, case-insensitively.
+func IsCountryCodeISO3(s string) ValidatorFunc {
+	return func() ValidationResult {
+		loadISO()
+		if !inCodeSet(isoAlpha3Set, strings.ToUpper(s)) {
+			return FailCode("iso.country_alpha3", "must be an ISO 3166-1 alpha-3 country code")
+		}
+		return Success()
+	}
+}
+
+// IsCurrencyCode validates an ISO 4217 currency code, case-insensitively.
+func IsCurrencyCode(s string) ValidatorFunc {
+	return func() ValidationResult {
+		loadISO()
+		if !inCodeSet(isoCurrencySet, strings.ToUpper(s)) {
+			return FailCode("iso.currency", "must be an ISO 4217 currency code")
+		}
+		return Success()
+	}
+}
+
+// IsLanguageCode validates an ISO 639-1 language code, case-insensitively.
+func IsLanguageCode(s string) ValidatorFunc {
+	return func() ValidationResult {
+		loadISO()
+		if !inCodeSet(isoLangSet, strings.ToLower(s)) {
+			return FailCode("iso.language", "must be an ISO 639-1 language code")
+		}
+		return Success()
+	}
+}
+
+// IsBIC validates an ISO 9362 BIC (SWIFT code): 4 bank letters, an
+// ISO 3166-1 alpha-2 country, 2 alphanumeric location characters and an
+// optional 3-character branch code. Only uppercase is accepted.
+func IsBIC(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if (len(s) != 8 && len(s) != 11) || !isUpperAlpha(s[:6]) || !isUpperAlnum(s[6:]) {
+			return FailCode("iso.bic", "invalid BIC")
+		}
+		loadISO()
+		if !inCodeSet(isoAlpha2Set, s[4:6]) {
+			return FailCode("iso.bic", "invalid BIC")
+		}
+		return Success()
+	}
+}
+
+func isUpperAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isUpperAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'A' || s[i] > 'Z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestISOCodeRules(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"IsCountryCodeISO2 ok", IsCountryCodeISO2("US"), true, nil},
+		{"IsCountryCodeISO2 lower", IsCountryCodeISO2("de"), true, nil},
+		{"IsCountryCodeISO2 fail", IsCountryCodeISO2("XX"), false, []string{"must be an ISO 3166-1 alpha-2 country code"}},
+		{"IsCountryCodeISO2 alpha3", IsCountryCodeISO2("USA"), false, []string{"must be an ISO 3166-1 alpha-2 country code"}},
+		{"IsCountryCodeISO3 ok", IsCountryCodeISO3("USA"), true, nil},
+		{"IsCountryCodeISO3 lower", IsCountryCodeISO3("eth"), true, nil},
+		{"IsCountryCodeISO3 fail", IsCountryCodeISO3("USX"), false, []string{"must be an ISO 3166-1 alpha-3 country code"}},
+		{"IsCurrencyCode ok", IsCurrencyCode("USD"), true, nil},
+		{"IsCurrencyCode lower", IsCurrencyCode("eur"), true, nil},
+		{"IsCurrencyCode fail", IsCurrencyCode("ABC"), false, []string{"must be an ISO 4217 currency code"}},
+		{"IsLanguageCode ok", IsLanguageCode("en"), true, nil},
+		{"IsLanguageCode upper", IsLanguageCode("EN"), true, nil},
+		{"IsLanguageCode fail", IsLanguageCode("eng"), false, []string{"must be an ISO 639-1 language code"}},
+		{"IsLanguageCode empty", IsLanguageCode(""), false, []string{"must be an ISO 639-1 language code"}},
+		{"IsBIC 8 chars", IsBIC("DEUTDEFF"), true, nil},
+		{"IsBIC 11 chars", IsBIC("DEUTDEFF500"), true, nil},
+		{"IsBIC digit location", IsBIC("BOFAUS3N"), true, nil},
+		{"IsBIC bad country", IsBIC("DEUTXXFF"), false, []string{"invalid BIC"}},
+		{"IsBIC lowercase", IsBIC("deutdeff"), false, []string{"invalid BIC"}},
+		{"IsBIC digit in bank", IsBIC("DE1TDEFF"), false, []string{"invalid BIC"}},
+		{"IsBIC wrong length", IsBIC("DEUTDEFF5"), false, []string{"invalid BIC"}},
+		{"IsBIC bad branch", IsBIC("DEUTDEFF50-"), false, []string{"invalid BIC"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestISOTables(t *testing.T) {
+	t.Parallel()
+	if a2, a3 := len(strings.Fields(isoCountryAlpha2)), len(strings.Fields(isoCountryAlpha3)); a2 != 249 || a3 != 249 {
+		t.Fatalf("country tables have %d alpha-2 and %d alpha-3 codes, want 249", a2, a3)
+	}
+	if n := len(strings.Fields(isoLanguage)); n != 183 {
+		t.Fatalf("language table has %d codes, want 183", n)
+	}
+}
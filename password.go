@@ -0,0 +1,157 @@
+package validate
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PasswordOpts configures PasswordPolicy. Zero values disable a requirement.
+type PasswordOpts struct {
+	MinLength  int // minimum number of characters (runes)
+	MinUpper   int // minimum number of uppercase letters
+	MinLower   int // minimum number of lowercase letters
+	MinDigits  int // minimum number of digits
+	MinSpecial int // minimum number of punctuation or symbol characters
+
+	// NoCommonPassword rejects passwords found in a small built-in deny
+	// list of commonly used passwords (compared case-insensitively).
+	NoCommonPassword bool
+}
+
+// commonPasswords is a deliberately small deny list of the most frequently
+// leaked passwords. It is not a substitute for a breach-corpus check.
+var commonPasswords = map[string]struct{}{
+	"123456": {}, "123456789": {}, "12345678": {}, "12345": {}, "1234567": {},
+	"1234567890": {}, "111111": {}, "000000": {}, "123123": {}, "654321": {},
+	"password": {}, "password1": {}, "password123": {}, "passw0rd": {}, "p@ssw0rd": {},
+	"qwerty": {}, "qwerty123": {}, "qwertyuiop": {}, "1q2w3e4r": {}, "asdfghjkl": {},
+	"abc123": {}, "iloveyou": {}, "admin": {}, "admin123": {}, "welcome": {},
+	"welcome1": {}, "letmein": {}, "monkey": {}, "dragon": {}, "football": {},
+	"baseball": {}, "sunshine": {}, "princess": {}, "superman": {}, "trustno1": {},
+	"master": {}, "shadow": {}, "michael": {}, "login": {}, "starwars": {},
+	"changeme": {}, "secret": {}, "zaq12wsx": {}, "hello123": {}, "whatever": {},
+}
+
+// PasswordPolicy validates s against opts and, unlike most rules, reports
+// every unmet requirement as a separate message.
+func PasswordPolicy(s string, opts PasswordOpts) ValidatorFunc {
+	return func() ValidationResult {
+		var n, upper, lower, digits, special int
+		for _, r := range s {
+			n++
+			switch {
+			case unicode.IsUpper(r):
+				upper++
+			case unicode.IsLower(r):
+				lower++
+			case unicode.IsDigit(r):
+				digits++
+			case unicode.IsPunct(r) || unicode.IsSymbol(r):
+				special++
+			}
+		}
+
+		var messages []string
+		var failures []failure
+		add := func(res ValidationResult) {
+			messages, failures = appendFailures(messages, failures, res)
+		}
+		if n < opts.MinLength {
+			add(FailCode("password.min_len", "too short: min "+strconv.Itoa(opts.MinLength), "min", opts.MinLength))
+		}
+		if upper < opts.MinUpper {
+			add(FailCode("password.upper", "must contain at least "+strconv.Itoa(opts.MinUpper)+" uppercase letter(s)", "min", opts.MinUpper))
+		}
+		if lower < opts.MinLower {
+			add(FailCode("password.lower", "must contain at least "+strconv.Itoa(opts.MinLower)+" lowercase letter(s)", "min", opts.MinLower))
+		}
+		if digits < opts.MinDigits {
+			add(FailCode("password.digits", "must contain at least "+strconv.Itoa(opts.MinDigits)+" digit(s)", "min", opts.MinDigits))
+		}
+		if special < opts.MinSpecial {
+			add(FailCode("password.special", "must contain at least "+strconv.Itoa(opts.MinSpecial)+" special character(s)", "min", opts.MinSpecial))
+		}
+		if opts.NoCommonPassword {
+			if _, ok := commonPasswords[strings.ToLower(s)]; ok {
+				add(FailCode("password.common", "must not be a common password"))
+			}
+		}
+		if len(messages) > 0 {
+			return ValidationResult{IsValid: false, Message: messages, failures: failures}
+		}
+		return Success()
+	}
+}
+
+// Character pool sizes used by PasswordEntropy.
+const (
+	poolLower  = 26
+	poolUpper  = 26
+	poolDigits = 10
+	poolSymbol = 33  // ASCII punctuation and space
+	poolOther  = 100 // rough allowance for any non-ASCII character
+)
+
+// PasswordEntropy estimates the entropy of s in bits as
+//
+//	effective length × log2(pool size)
+//
+// where the pool is the sum of the sizes of the character classes present
+// (lowercase 26, uppercase 26, digits 10, ASCII symbols and space 33,
+// anything else 100). A character that repeats the previous one or
+// continues an ascending or descending run ("aaa", "abc", "321") does not
+// count towards the effective length. It is a coarse estimate that does
+// not detect dictionary words.
+func PasswordEntropy(s string) float64 {
+	var lower, upper, digits, symbols, other bool
+	n := 0
+	prev, step := rune(-1), rune(0)
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digits = true
+		case r >= ' ' && r <= '~':
+			symbols = true
+		default:
+			other = true
+		}
+		d := r - prev
+		if prev < 0 || d < -1 || d > 1 || (step != 0 && d != step) {
+			n++
+			step = 0
+		} else {
+			step = d
+		}
+		prev = r
+	}
+	pool := 0
+	for _, c := range []struct {
+		present bool
+		size    int
+	}{{lower, poolLower}, {upper, poolUpper}, {digits, poolDigits}, {symbols, poolSymbol}, {other, poolOther}} {
+		if c.present {
+			pool += c.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(n) * math.Log2(float64(pool))
+}
+
+// PasswordMinEntropy validates that the PasswordEntropy of s is at least
+// bits.
+func PasswordMinEntropy(s string, bits float64) ValidatorFunc {
+	return func() ValidationResult {
+		if PasswordEntropy(s) < bits {
+			return FailCode("password.entropy", "password too weak", "min_bits", bits)
+		}
+		return Success()
+	}
+}
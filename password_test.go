@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	t.Parallel()
+	opts := PasswordOpts{MinLength: 8, MinUpper: 1, MinLower: 1, MinDigits: 1, MinSpecial: 1, NoCommonPassword: true}
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"all met", PasswordPolicy("Sup3r$ecret", opts), true, nil},
+		{"too short", PasswordPolicy("Ab1$", opts), false, []string{"too short: min 8"}},
+		{"no upper", PasswordPolicy("sup3r$ecret", opts), false, []string{"must contain at least 1 uppercase letter(s)"}},
+		{"no lower", PasswordPolicy("SUP3R$ECRET", opts), false, []string{"must contain at least 1 lowercase letter(s)"}},
+		{"no digit", PasswordPolicy("Super$ecret", opts), false, []string{"must contain at least 1 digit(s)"}},
+		{"no special", PasswordPolicy("Sup3rSecret", opts), false, []string{"must contain at least 1 special character(s)"}},
+		{"common", PasswordPolicy("P@ssw0rd", PasswordOpts{NoCommonPassword: true}), false, []string{"must not be a common password"}},
+		{"common disabled", PasswordPolicy("P@ssw0rd", PasswordOpts{}), true, nil},
+		{"all fail together", PasswordPolicy("", opts), false, []string{
+			"too short: min 8",
+			"must contain at least 1 uppercase letter(s)",
+			"must contain at least 1 lowercase letter(s)",
+			"must contain at least 1 digit(s)",
+			"must contain at least 1 special character(s)",
+		}},
+		{"counts unicode", PasswordPolicy("ÄÖÜäöü12", PasswordOpts{MinLength: 8, MinUpper: 3, MinLower: 3, MinDigits: 2}), true, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+
+	res := PasswordPolicy("abc", opts).Validate()
+	if !res.HasCode("password.upper") || !res.HasCode("password.min_len") {
+		t.Fatalf("expected per-requirement codes, got %v", res.Failures())
+	}
+}
+
+func TestPasswordMinEntropy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"long passphrase passes", PasswordMinEntropy("correct horse battery staple", 80), true, nil},
+		{"short complex string fails", PasswordMinEntropy("Tr0ub4dor&3", 80), false, []string{"password too weak"}},
+		{"short complex string passes lower bar", PasswordMinEntropy("Tr0ub4dor&3", 60), true, nil},
+		{"repeats penalized", PasswordMinEntropy("aaaaaaaaaaaaaaaaaaaaaaaa", 20), false, []string{"password too weak"}},
+		{"sequences penalized", PasswordMinEntropy("abcdefghijklmnop12345678", 20), false, []string{"password too weak"}},
+		{"empty", PasswordMinEntropy("", 1), false, []string{"password too weak"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+
+	// 4 lowercase letters without runs: 4 × log2(26).
+	if got, want := PasswordEntropy("qxzk"), 4*math.Log2(26); math.Abs(got-want) > 1e-9 {
+		t.Fatalf("PasswordEntropy=%v want %v", got, want)
+	}
+}
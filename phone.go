@@ -0,0 +1,151 @@
+package validate
+
+import (
+	"strings"
+	"sync"
+)
+
+// PhoneChecker decides whether an E.164 number is possible for a region
+// (an ISO 3166-1 alpha-2 code). Implement it to plug in a full numbering
+// plan library such as libphonenumber.
+type PhoneChecker interface {
+	IsPossible(e164, region string) bool
+}
+
+// PhoneCheckerFunc is an adapter to allow the use of ordinary functions as phone checkers.
+type PhoneCheckerFunc func(e164, region string) bool
+
+// IsPossible calls the underlying function.
+func (f PhoneCheckerFunc) IsPossible(e164, region string) bool { return f(e164, region) }
+
+// phonePlan is the calling code, national significant number length range
+// and domestic trunk prefix of a region.
+type phonePlan struct {
+	code     string
+	min, max int
+	trunk    string
+}
+
+var phonePlans = map[string]phonePlan{
+	"US": {"1", 10, 10, "1"},
+	"CA": {"1", 10, 10, "1"},
+	"GB": {"44", 9, 10, "0"},
+	"DE": {"49", 6, 13, "0"},
+	"FR": {"33", 9, 9, "0"},
+	"IT": {"39", 6, 11, ""},
+	"ES": {"34", 9, 9, ""},
+	"NL": {"31", 9, 9, "0"},
+	"SE": {"46", 7, 13, "0"},
+	"RU": {"7", 10, 10, "8"},
+	"IN": {"91", 10, 10, "0"},
+	"CN": {"86", 9, 11, "0"},
+	"JP": {"81", 9, 10, "0"},
+	"AU": {"61", 9, 9, "0"},
+	"BR": {"55", 10, 11, "0"},
+	"MX": {"52", 10, 10, ""},
+	"ZA": {"27", 9, 9, "0"},
+	"NG": {"234", 8, 10, "0"},
+	"KE": {"254", 9, 9, "0"},
+	"ET": {"251", 9, 9, "0"},
+}
+
+// lengthPhoneChecker is the built-in checker used by PhoneValidForRegion. It
+// only verifies the calling code and the length of the national number.
+type lengthPhoneChecker struct{}
+
+func (lengthPhoneChecker) IsPossible(e164, region string) bool {
+	plan, ok := phonePlans[strings.ToUpper(region)]
+	if !ok || !strings.HasPrefix(e164, "+"+plan.code) {
+		return false
+	}
+	n := len(e164) - 1 - len(plan.code)
+	return n >= plan.min && n <= plan.max
+}
+
+// PhoneValidForRegion validates that s is an E.164 number that is possible
+// for region, checking only its calling code and length. Use
+// PhoneValidForRegionWith to plug in a full numbering plan.
+func PhoneValidForRegion(s, region string) ValidatorFunc {
+	return PhoneValidForRegionWith(s, region, lengthPhoneChecker{})
+}
+
+// PhoneValidForRegionWith is like PhoneValidForRegion but uses checker.
+func PhoneValidForRegionWith(s, region string, checker PhoneChecker) ValidatorFunc {
+	return func() ValidationResult {
+		if !reE164.MatchString(s) {
+			return FailCode("phone.e164", "invalid phone (use E.164, e.g. +15551234567)")
+		}
+		if !checker.IsPossible(s, region) {
+			return FailCode("phone.region", "phone number not possible for region "+region, "region", region)
+		}
+		return Success()
+	}
+}
+
+// NormalizePhone turns a loosely formatted phone number into canonical
+// E.164. Spaces, dashes, dots and parentheses are removed. Numbers without
+// a leading "+" are taken as national numbers of defaultCountry: its
+// domestic trunk prefix (e.g. the leading 0 in the UK) is dropped and its
+// calling code added, so "(555) 123-4567" with "US" becomes "+15551234567".
+// On failure the returned string is empty.
+func NormalizePhone(s, defaultCountry string) (string, ValidationResult) {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(s) {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return "", FailCode("phone.normalize", "cannot normalize phone number")
+		}
+	}
+	n := b.String()
+	if !strings.HasPrefix(n, "+") {
+		region := strings.ToUpper(defaultCountry)
+		plan, ok := phonePlans[region]
+		if !ok {
+			return "", FailCode("phone.region", "unsupported phone region "+defaultCountry, "region", defaultCountry)
+		}
+		if plan.trunk != "" && strings.HasPrefix(n, plan.trunk) && len(n)-len(plan.trunk) >= plan.min {
+			n = n[len(plan.trunk):]
+		}
+		n = "+" + plan.code + n
+	}
+	if !reE164.MatchString(n) {
+		return "", FailCode("phone.normalize", "cannot normalize phone number")
+	}
+	return n, Success()
+}
+
+// e164CallingCodes lists the country calling codes assigned by ITU-T E.164,
+// including codes for international networks and global services (e.g. 800,
+// 881-883); spare and reserved codes such as 999 are absent.
+const e164CallingCodes = `1 7 20 27 30 31 32 33 34 36 39 40 41 43 44 45 46 47 48 49 51 52 53 54 55 56
+57 58 60 61 62 63 64 65 66 81 82 84 86 90 91 92 93 94 95 98 211 212 213 216 218 220 221 222 223
+224 225 226 227 228 229 230 231 232 233 234 235 236 237 238 239 240 241 242 243 244 245 246 247
+248 249 250 251 252 253 254 255 256 257 258 260 261 262 263 264 265 266 267 268 269 290 291 297
+298 299 350 351 352 353 354 355 356 357 358 359 370 371 372 373 374 375 376 377 378 379 380 381
+382 383 385 386 387 389 420 421 423 500 501 502 503 504 505 506 507 508 509 590 591 592 593 594
+595 596 597 598 599 670 672 673 674 675 676 677 678 679 680 681 682 683 685 686 687 688 689 690
+691 692 800 808 850 852 853 855 856 870 878 880 881 882 883 886 888 960 961 962 963 964 965 966
+967 968 970 971 972 973 974 975 976 977 979 992 993 994 995 996 998`
+
+var (
+	callingCodesOnce sync.Once
+	callingCodeSet   map[string]struct{}
+)
+
+// IsCallingCode validates a country calling code such as "+1" or "251": an
+// optional "+" and 1 to 3 digits forming a code assigned in E.164.
+func IsCallingCode(s string) ValidatorFunc {
+	return func() ValidationResult {
+		callingCodesOnce.Do(func() { callingCodeSet = codeSet(e164CallingCodes) })
+		code := strings.TrimPrefix(s, "+")
+		if len(code) < 1 || len(code) > 3 || !inCodeSet(callingCodeSet, code) {
+			return FailCode("phone.calling_code", "invalid country calling code")
+		}
+		return Success()
+	}
+}
@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPhoneValidForRegion(t *testing.T) {
+	t.Parallel()
+	onlyUK := PhoneCheckerFunc(func(e164, region string) bool { return region == "GB" })
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"US ok", PhoneValidForRegion("+14155552671", "US"), true, nil},
+		{"US lowercase region", PhoneValidForRegion("+14155552671", "us"), true, nil},
+		{"US too short", PhoneValidForRegion("+1415555267", "US"), false, []string{"phone number not possible for region US"}},
+		{"wrong calling code", PhoneValidForRegion("+442071838750", "US"), false, []string{"phone number not possible for region US"}},
+		{"GB ok", PhoneValidForRegion("+442071838750", "GB"), true, nil},
+		{"ET ok", PhoneValidForRegion("+251911234567", "ET"), true, nil},
+		{"unknown region", PhoneValidForRegion("+14155552671", "XX"), false, []string{"phone number not possible for region XX"}},
+		{"not E.164", PhoneValidForRegion("415-555-2671", "US"), false, []string{"invalid phone (use E.164, e.g. +15551234567)"}},
+		{"custom checker", PhoneValidForRegionWith("+14155552671", "GB", onlyUK), true, nil},
+		{"custom checker fail", PhoneValidForRegionWith("+14155552671", "US", onlyUK), false, []string{"phone number not possible for region US"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestIsCallingCode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"calling code +1", IsCallingCode("+1"), true, nil},
+		{"calling code +251", IsCallingCode("+251"), true, nil},
+		{"calling code bare 1", IsCallingCode("1"), true, nil},
+		{"calling code global service", IsCallingCode("+800"), true, nil},
+		{"calling code +999", IsCallingCode("+999"), false, []string{"invalid country calling code"}},
+		{"calling code unassigned", IsCallingCode("+28"), false, []string{"invalid country calling code"}},
+		{"calling code too long", IsCallingCode("+1234"), false, []string{"invalid country calling code"}},
+		{"calling code plus only", IsCallingCode("+"), false, []string{"invalid country calling code"}},
+		{"calling code double plus", IsCallingCode("++1"), false, []string{"invalid country calling code"}},
+		{"calling code leading zero", IsCallingCode("+01"), false, []string{"invalid country calling code"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		in        string
+		country   string
+		want      string
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"US national", "(555) 123-4567", "US", "+15551234567", true, nil},
+		{"US with trunk 1", "1-555-123-4567", "us", "+15551234567", true, nil},
+		{"already international", "+44 20 7183 8750", "US", "+442071838750", true, nil},
+		{"GB trunk zero", "020 7183 8750", "GB", "+442071838750", true, nil},
+		{"IT keeps leading zero", "06 1234 5678", "IT", "+390612345678", true, nil},
+		{"dots", "555.123.4567", "US", "+15551234567", true, nil},
+		{"letters", "555-CALL-NOW", "US", "", false, []string{"cannot normalize phone number"}},
+		{"plus in the middle", "555+1234567", "US", "", false, []string{"cannot normalize phone number"}},
+		{"too short", "123", "US", "", false, []string{"cannot normalize phone number"}},
+		{"unknown default country", "5551234567", "XX", "", false, []string{"unsupported phone region XX"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, res := NormalizePhone(tc.in, tc.country)
+			if got != tc.want {
+				t.Fatalf("NormalizePhone=%q want %q", got, tc.want)
+			}
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// RuleFactory builds a Validator from string arguments. By convention the
+// first argument is the value under test and the rest configure the rule,
+// e.g. BuildRule("min_len", name, "3").
+type RuleFactory func(args ...string) (Validator, error)
+
+var ruleRegistry = struct {
+	sync.RWMutex
+	m map[string]RuleFactory
+}{m: builtinRules()}
+
+// RegisterRule makes a rule available to BuildRule under name, replacing any
+// rule already registered with that name. It panics if name is empty or
+// factory is nil.
+func RegisterRule(name string, factory RuleFactory) {
+	if name == "" {
+		panic("validate: RegisterRule with empty name")
+	}
+	if factory == nil {
+		panic("validate: RegisterRule with nil factory for " + strconv.Quote(name))
+	}
+	ruleRegistry.Lock()
+	ruleRegistry.m[name] = factory
+	ruleRegistry.Unlock()
+}
+
+// BuildRule looks up the rule registered under name and builds it with args.
+func BuildRule(name string, args ...string) (Validator, error) {
+	v, err := buildRule(name, args)
+	if err != nil {
+		return nil, fmt.Errorf("validate: %w", err)
+	}
+	return v, nil
+}
+
+func buildRule(name string, args []string) (Validator, error) {
+	ruleRegistry.RLock()
+	factory, ok := ruleRegistry.m[name]
+	ruleRegistry.RUnlock()
+	if !ok {
+		return nil, errors.New("unknown rule " + strconv.Quote(name))
+	}
+	v, err := factory(args...)
+	if err != nil {
+		return nil, fmt.Errorf("rule %q: %w", name, err)
+	}
+	return v, nil
+}
+
+func builtinRules() map[string]RuleFactory {
+	return map[string]RuleFactory{
+		// String rules
+		"non_empty":          stringRule(NonEmpty),
+		"min_len":            stringIntRule(MinLen),
+		"max_len":            stringIntRule(MaxLen),
+		"len_between":        stringIntIntRule(LenBetween),
+		"matches":            stringStringRule(MatchesString),
+		"one_of":             stringListRule(func(s string, l []string) ValidatorFunc { return OneOf(s, l, true) }),
+		"has_prefix":         stringStringRule(HasPrefix),
+		"has_suffix":         stringStringRule(HasSuffix),
+		"contains":           stringStringRule(Contains),
+		"starts_with_any":    stringListRule(StartsWithAny),
+		"ends_with_any":      stringListRule(EndsWithAny),
+		"contains_any":       stringListRule(ContainsAny),
+		"contains_none":      stringListRule(ContainsNone),
+		"trimmed":            stringRule(Trimmed),
+		"min_words":          stringIntRule(MinWords),
+		"max_words":          stringIntRule(MaxWords),
+		"alpha":              stringRule(IsAlpha),
+		"numeric":            stringRule(IsNumeric),
+		"alnum":              stringRule(IsAlnum),
+		"lowercase":          stringRule(IsLowercase),
+		"uppercase":          stringRule(IsUppercase),
+		"title_case":         stringRule(IsTitleCase),
+		"ascii":              stringRule(IsASCII),
+		"printable_ascii":    stringRule(IsPrintableASCII),
+		"hex":                stringRule(IsHex),
+		"base64":             stringRule(IsBase64),
+		"mime_type":          stringRule(IsMIMEType),
+		"data_uri":           stringRule(IsDataURI),
+		"slug":               stringRule(IsSlug),
+		"uuid_v4":            stringRule(IsUUIDv4),
+		"ulid":               stringRule(IsULID),
+		"mongo_object_id":    stringRule(IsMongoObjectID),
+		"email":              stringRule(EmailValid),
+		"phone_e164":         stringRule(PhoneE164),
+		"url":                stringRule(IsURL),
+		"url_with_schemes":   stringListRule(func(s string, l []string) ValidatorFunc { return IsURLWithSchemes(s, l...) }),
+		"hostname":           stringRule(IsHostname),
+		"ip":                 stringRule(IsIP),
+		"ipv4":               stringRule(IsIPv4),
+		"ipv6":               stringRule(IsIPv6),
+		"public_ip":          stringRule(IsPublicIP),
+		"private_ip":         stringRule(IsPrivateIP),
+		"cidr":               stringRule(IsCIDR),
+		"ip_in_cidr":         stringStringRule(IPInCIDR),
+		"port_string":        stringRule(IsPortString),
+		"rfc3339":            stringRule(IsRFC3339),
+		"date_only":          stringRule(IsDateOnly),
+		"time_format":        stringStringRule(IsTimeFormat),
+		"timezone":           stringRule(IsTimezone),
+		"luhn":               stringRule(LuhnValid),
+		"country_code_iso2":  stringRule(IsCountryCodeISO2),
+		"country_code_iso3":  stringRule(IsCountryCodeISO3),
+		"currency_code":      stringRule(IsCurrencyCode),
+		"language_code":      stringRule(IsLanguageCode),
+		"email_domain_allow": stringListRule(EmailDomainAllowlist),
+		"email_domain_block": stringListRule(EmailDomainBlocklist),
+
+		// Int rules
+		"int_min":          intIntRule(IntMin),
+		"int_max":          intIntRule(IntMax),
+		"int_between":      intIntIntRule(IntBetween),
+		"int_non_zero":     intRule(IntNonZero),
+		"int_positive":     intRule(IntPositive),
+		"int_non_negative": intRule(IntNonNegative),
+		"int_greater_than": intIntRule(IntGreaterThan),
+		"int_less_than":    intIntRule(IntLessThan),
+		"int_multiple_of":  intIntRule(IntMultipleOf),
+		"port":             intRule(IsPort),
+		"port_or_zero":     intRule(IsPortOrZero),
+	}
+}
+
+func wantArgs(args []string, n int) error {
+	if len(args) != n {
+		return errors.New("expects " + strconv.Itoa(n) + " args, got " + strconv.Itoa(len(args)))
+	}
+	return nil
+}
+
+func intArg(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.New("invalid int arg " + strconv.Quote(s))
+	}
+	return n, nil
+}
+
+func intArgs(args []string) ([]int, error) {
+	out := make([]int, len(args))
+	for i, a := range args {
+		n, err := intArg(a)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = n
+	}
+	return out, nil
+}
+
+func stringRule(rule func(string) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 1); err != nil {
+			return nil, err
+		}
+		return rule(args[0]), nil
+	}
+}
+
+func stringStringRule(rule func(string, string) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 2); err != nil {
+			return nil, err
+		}
+		return rule(args[0], args[1]), nil
+	}
+}
+
+func stringIntRule(rule func(string, int) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 2); err != nil {
+			return nil, err
+		}
+		n, err := intArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		return rule(args[0], n), nil
+	}
+}
+
+func stringIntIntRule(rule func(string, int, int) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 3); err != nil {
+			return nil, err
+		}
+		n, err := intArgs(args[1:])
+		if err != nil {
+			return nil, err
+		}
+		return rule(args[0], n[0], n[1]), nil
+	}
+}
+
+// stringListRule passes every argument after the value as the list.
+func stringListRule(rule func(string, []string) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if len(args) < 1 {
+			return nil, errors.New("expects at least 1 arg, got 0")
+		}
+		return rule(args[0], args[1:]), nil
+	}
+}
+
+func intRule(rule func(int) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 1); err != nil {
+			return nil, err
+		}
+		n, err := intArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return rule(n), nil
+	}
+}
+
+func intIntRule(rule func(int, int) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 2); err != nil {
+			return nil, err
+		}
+		n, err := intArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return rule(n[0], n[1]), nil
+	}
+}
+
+func intIntIntRule(rule func(int, int, int) ValidatorFunc) RuleFactory {
+	return func(args ...string) (Validator, error) {
+		if err := wantArgs(args, 3); err != nil {
+			return nil, err
+		}
+		n, err := intArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return rule(n[0], n[1], n[2]), nil
+	}
+}
@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		rule      string
+		args      []string
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"non_empty ok", "non_empty", []string{"x"}, true, nil},
+		{"non_empty fail", "non_empty", []string{""}, false, []string{"must not be empty"}},
+		{"min_len fail", "min_len", []string{"ab", "3"}, false, []string{"too short: min 3"}},
+		{"len_between ok", "len_between", []string{"abc", "1", "5"}, true, nil},
+		{"one_of ok", "one_of", []string{"b", "a", "b"}, true, nil},
+		{"email fail", "email", []string{"nope"}, false, []string{"invalid email"}},
+		{"int_min fail", "int_min", []string{"12", "18"}, false, []string{"must be >= 18"}},
+		{"int_between ok", "int_between", []string{"3", "1", "5"}, true, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := BuildRule(tc.rule, tc.args...)
+			if err != nil {
+				t.Fatalf("BuildRule: %v", err)
+			}
+			res := v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestBuildRuleErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		rule    string
+		args    []string
+		wantErr string
+	}{
+		{"unknown", "no_such_rule", nil, `validate: unknown rule "no_such_rule"`},
+		{"arg count", "min_len", []string{"ab"}, `validate: rule "min_len": expects 2 args, got 1`},
+		{"bad int", "int_min", []string{"12", "x"}, `validate: rule "int_min": invalid int arg "x"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := BuildRule(tc.rule, tc.args...)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("err=%v want %s", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegisterRule(t *testing.T) {
+	t.Parallel()
+	RegisterRule("test_no_spaces", func(args ...string) (Validator, error) {
+		if len(args) != 1 {
+			return nil, errors.New("expects 1 arg")
+		}
+		return ValidatorFunc(func() ValidationResult {
+			if strings.Contains(args[0], " ") {
+				return FailCode("test.no_spaces", "must not contain spaces")
+			}
+			return Success()
+		}), nil
+	})
+	v, err := BuildRule("test_no_spaces", "a b")
+	if err != nil {
+		t.Fatalf("BuildRule: %v", err)
+	}
+	if res := v.Validate(); res.IsValid || !reflect.DeepEqual(res.Message, []string{"must not contain spaces"}) {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("RegisterRule with nil factory should panic")
+		}
+	}()
+	RegisterRule("test_nil", nil)
+}
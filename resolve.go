@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"context"
+	"net"
+)
+
+// HostResolver looks up the addresses of a host. *net.Resolver satisfies it.
+type HostResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DomainResolvable validates that host resolves to at least one address.
+// It performs a network lookup, so it is opt-in and only available as a
+// ContextValidator: the lookup honors the context's deadline and
+// cancellation. A nil resolver uses net.DefaultResolver; tests can inject
+// their own.
+func DomainResolvable(host string, r HostResolver) ContextValidatorFunc {
+	return func(ctx context.Context) ValidationResult {
+		resolver := r
+		if resolver == nil {
+			resolver = net.DefaultResolver
+		}
+		addrs, err := resolver.LookupHost(ctx, host)
+		if err != nil || len(addrs) == 0 {
+			return FailCode("net.resolvable", "domain does not resolve", "host", host)
+		}
+		return Success()
+	}
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if host == "slow.example" {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	addrs, ok := f[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func TestDomainResolvable(t *testing.T) {
+	t.Parallel()
+	r := fakeResolver{"example.com": {"93.184.216.34"}, "empty.example": {}}
+	tests := []struct {
+		name      string
+		host      string
+		wantValid bool
+	}{
+		{"resolves", "example.com", true},
+		{"no such host", "missing.example", false},
+		{"no addresses", "empty.example", false},
+		{"deadline exceeded", "slow.example", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			res := DomainResolvable(tc.host, r).ValidateContext(ctx)
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if !tc.wantValid && !reflect.DeepEqual(res.Message, []string{"domain does not resolve"}) {
+				t.Fatalf("msg=%v", res.Message)
+			}
+		})
+	}
+
+	res := New().And(NonEmpty("example.com")).And(WithContext(context.Background(), DomainResolvable("missing.example", r))).Validate()
+	if res.IsValid {
+		t.Fatalf("expected chain with unresolvable domain to fail")
+	}
+}
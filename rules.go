@@ -2,20 +2,47 @@ package validate
 
 import (
 	"encoding/base64"
+	"fmt"
+	"math"
+	"mime"
 	"net"
 	"net/url"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 // String rules
 func NonEmpty(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if s == "" {
-			return Fail("must not be empty")
+			return FailCode("string.non_empty", "must not be empty")
+		}
+		return Success()
+	}
+}
+
+// IsEmpty validates that s is empty, e.g. for a honeypot form field.
+func IsEmpty(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s != "" {
+			return FailCode("string.empty", "must be empty")
+		}
+		return Success()
+	}
+}
+
+// IsBlank is like IsEmpty but also accepts whitespace-only strings.
+func IsBlank(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if strings.TrimSpace(s) != "" {
+			return FailCode("string.blank", "must be blank")
 		}
 		return Success()
 	}
@@ -24,7 +51,7 @@ func NonEmpty(s string) ValidatorFunc {
 func MinLen(s string, n int) ValidatorFunc {
 	return func() ValidationResult {
 		if len(s) < n {
-			return Fail("too short: min " + strconv.Itoa(n))
+			return FailCode("string.min_len", "too short: min "+strconv.Itoa(n), "min", n)
 		}
 		return Success()
 	}
@@ -33,7 +60,7 @@ func MinLen(s string, n int) ValidatorFunc {
 func MaxLen(s string, n int) ValidatorFunc {
 	return func() ValidationResult {
 		if len(s) > n {
-			return Fail("too long: max " + strconv.Itoa(n))
+			return FailCode("string.max_len", "too long: max "+strconv.Itoa(n), "max", n)
 		}
 		return Success()
 	}
@@ -43,7 +70,38 @@ func LenBetween(s string, min, max int) ValidatorFunc {
 	return func() ValidationResult {
 		l := len(s)
 		if l < min || l > max {
-			return Fail("length must be between " + strconv.Itoa(min) + " and " + strconv.Itoa(max))
+			return FailCode("string.len_between", "length must be between "+strconv.Itoa(min)+" and "+strconv.Itoa(max), "min", min, "max", max)
+		}
+		return Success()
+	}
+}
+
+// ExactLen validates that s is exactly n bytes long, like MinLen and MaxLen
+// count. Use ExactRuneLen to count characters of multibyte input.
+func ExactLen(s string, n int) ValidatorFunc {
+	return func() ValidationResult {
+		if len(s) != n {
+			return FailCode("string.exact_len", "must be exactly "+strconv.Itoa(n)+" characters", "len", n)
+		}
+		return Success()
+	}
+}
+
+// ExactRuneLen validates that s is exactly n runes long.
+func ExactRuneLen(s string, n int) ValidatorFunc {
+	return func() ValidationResult {
+		if utf8.RuneCountInString(s) != n {
+			return FailCode("string.exact_len", "must be exactly "+strconv.Itoa(n)+" characters", "len", n)
+		}
+		return Success()
+	}
+}
+
+// LenNot validates that s is not exactly n bytes long.
+func LenNot(s string, n int) ValidatorFunc {
+	return func() ValidationResult {
+		if len(s) == n {
+			return FailCode("string.len_not", "must not be "+strconv.Itoa(n)+" characters", "len", n)
 		}
 		return Success()
 	}
@@ -52,7 +110,84 @@ func LenBetween(s string, min, max int) ValidatorFunc {
 func Matches(s string, re *regexp.Regexp) ValidatorFunc {
 	return func() ValidationResult {
 		if !re.MatchString(s) {
-			return Fail("must match pattern")
+			return FailCode("string.matches", "must match pattern")
+		}
+		return Success()
+	}
+}
+
+// MatchesAny validates that s matches at least one of res.
+func MatchesAny(s string, res []*regexp.Regexp) ValidatorFunc {
+	return func() ValidationResult {
+		for _, re := range res {
+			if re.MatchString(s) {
+				return Success()
+			}
+		}
+		return FailCode("string.matches_any", "must match one of the allowed patterns")
+	}
+}
+
+// MatchesAll validates that s matches every pattern in res, e.g. "has a
+// digit" and "has a symbol". Unlike chaining Matches steps it reports every
+// pattern that did not match, one message each.
+func MatchesAll(s string, res []*regexp.Regexp) ValidatorFunc {
+	return func() ValidationResult {
+		var messages []string
+		var failures []failure
+		for _, re := range res {
+			if !re.MatchString(s) {
+				messages, failures = appendFailures(messages, failures,
+					FailCode("string.matches_all", "must match pattern "+re.String(), "pattern", re.String()))
+			}
+		}
+		if len(messages) > 0 {
+			return ValidationResult{IsValid: false, Message: messages, failures: failures}
+		}
+		return Success()
+	}
+}
+
+// MatchesNone validates that s matches none of the deny patterns res.
+func MatchesNone(s string, res []*regexp.Regexp) ValidatorFunc {
+	return func() ValidationResult {
+		for _, re := range res {
+			if re.MatchString(s) {
+				return FailCode("string.matches_none", "must not match a forbidden pattern", "pattern", re.String())
+			}
+		}
+		return Success()
+	}
+}
+
+// patternCache maps a pattern string to its compiled *regexp.Regexp, or to
+// nil when the pattern failed to compile. It grows with every distinct
+// pattern, so patterns should come from code or config, not user input.
+var patternCache sync.Map
+
+func compilePattern(pattern string) *regexp.Regexp {
+	if v, ok := patternCache.Load(pattern); ok {
+		return v.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = nil
+	}
+	patternCache.Store(pattern, re)
+	return re
+}
+
+// MatchesString is like Matches but takes the pattern as a string. Each
+// pattern is compiled once and cached; an invalid pattern fails with
+// "invalid pattern" instead of panicking.
+func MatchesString(s, pattern string) ValidatorFunc {
+	return func() ValidationResult {
+		re := compilePattern(pattern)
+		if re == nil {
+			return FailCode("string.invalid_pattern", "invalid pattern", "pattern", pattern)
+		}
+		if !re.MatchString(s) {
+			return FailCode("string.matches", "must match pattern")
 		}
 		return Success()
 	}
@@ -71,7 +206,58 @@ func OneOf(s string, allowed []string, caseSensitive bool) ValidatorFunc {
 				return Success()
 			}
 		}
-		return Fail("must be one of: " + strings.Join(allowed, ", "))
+		return FailCode("string.one_of", "must be one of: "+strings.Join(allowed, ", "), "allowed", allowed)
+	}
+}
+
+// OneOfFold validates that s equals one of allowed under Unicode case
+// folding (strings.EqualFold), which is more precise than lowercasing.
+func OneOfFold(s string, allowed []string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, a := range allowed {
+			if strings.EqualFold(s, a) {
+				return Success()
+			}
+		}
+		return FailCode("string.one_of", "must be one of: "+strings.Join(allowed, ", "), "allowed", allowed)
+	}
+}
+
+// EqualsFold validates that s equals want under Unicode case folding.
+func EqualsFold(s, want string) ValidatorFunc {
+	return func() ValidationResult {
+		if !strings.EqualFold(s, want) {
+			return FailCode("string.equal", "must equal "+want, "want", want)
+		}
+		return Success()
+	}
+}
+
+// NotEqualsFold validates that s differs from want under Unicode case folding.
+func NotEqualsFold(s, want string) ValidatorFunc {
+	return func() ValidationResult {
+		if strings.EqualFold(s, want) {
+			return FailCode("string.not_equal", "must not equal "+want, "want", want)
+		}
+		return Success()
+	}
+}
+
+// IsEnum validates that v is one of the allowed values of a typed enum.
+// Unlike OneOf, the message names the enum, e.g.
+// "invalid status: must be one of active, archived".
+func IsEnum[T comparable](v T, allowed []T, name string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, a := range allowed {
+			if v == a {
+				return Success()
+			}
+		}
+		values := make([]string, len(allowed))
+		for i, a := range allowed {
+			values[i] = fmt.Sprint(a)
+		}
+		return FailCode("value.enum", "invalid "+name+": must be one of "+strings.Join(values, ", "), "name", name, "allowed", values)
 	}
 }
 
@@ -79,7 +265,7 @@ func OneOf(s string, allowed []string, caseSensitive bool) ValidatorFunc {
 func IntMin(v, min int) ValidatorFunc {
 	return func() ValidationResult {
 		if v < min {
-			return Fail("must be >= " + strconv.Itoa(min))
+			return FailCode("number.min", "must be >= "+strconv.Itoa(min), "min", min)
 		}
 		return Success()
 	}
@@ -87,7 +273,7 @@ func IntMin(v, min int) ValidatorFunc {
 func IntMax(v, max int) ValidatorFunc {
 	return func() ValidationResult {
 		if v > max {
-			return Fail("must be <= " + strconv.Itoa(max))
+			return FailCode("number.max", "must be <= "+strconv.Itoa(max), "max", max)
 		}
 		return Success()
 	}
@@ -95,7 +281,7 @@ func IntMax(v, max int) ValidatorFunc {
 func IntBetween(v, min, max int) ValidatorFunc {
 	return func() ValidationResult {
 		if v < min || v > max {
-			return Fail("must be between " + strconv.Itoa(min) + " and " + strconv.Itoa(max))
+			return FailCode("number.between", "must be between "+strconv.Itoa(min)+" and "+strconv.Itoa(max), "min", min, "max", max)
 		}
 		return Success()
 	}
@@ -103,7 +289,85 @@ func IntBetween(v, min, max int) ValidatorFunc {
 func IntNonZero(v int) ValidatorFunc {
 	return func() ValidationResult {
 		if v == 0 {
-			return Fail("must not be zero")
+			return FailCode("number.non_zero", "must not be zero")
+		}
+		return Success()
+	}
+}
+
+// Uint rules mirror the Int family for uint64 values, avoiding lossy
+// conversions above math.MaxInt.
+func UintMin(v, min uint64) ValidatorFunc {
+	return func() ValidationResult {
+		if v < min {
+			return FailCode("number.min", "must be >= "+strconv.FormatUint(min, 10), "min", min)
+		}
+		return Success()
+	}
+}
+func UintMax(v, max uint64) ValidatorFunc {
+	return func() ValidationResult {
+		if v > max {
+			return FailCode("number.max", "must be <= "+strconv.FormatUint(max, 10), "max", max)
+		}
+		return Success()
+	}
+}
+func UintBetween(v, min, max uint64) ValidatorFunc {
+	return func() ValidationResult {
+		if v < min || v > max {
+			return FailCode("number.between", "must be between "+strconv.FormatUint(min, 10)+" and "+strconv.FormatUint(max, 10), "min", min, "max", max)
+		}
+		return Success()
+	}
+}
+func UintNonZero(v uint64) ValidatorFunc {
+	return func() ValidationResult {
+		if v == 0 {
+			return FailCode("number.non_zero", "must not be zero")
+		}
+		return Success()
+	}
+}
+
+// Int64 rules mirror the Int family for int64 values, e.g. database IDs,
+// so they keep their full range on 32-bit platforms.
+func Int64Min(v, min int64) ValidatorFunc {
+	return func() ValidationResult {
+		if v < min {
+			return FailCode("number.min", "must be >= "+strconv.FormatInt(min, 10), "min", min)
+		}
+		return Success()
+	}
+}
+func Int64Max(v, max int64) ValidatorFunc {
+	return func() ValidationResult {
+		if v > max {
+			return FailCode("number.max", "must be <= "+strconv.FormatInt(max, 10), "max", max)
+		}
+		return Success()
+	}
+}
+func Int64Between(v, min, max int64) ValidatorFunc {
+	return func() ValidationResult {
+		if v < min || v > max {
+			return FailCode("number.between", "must be between "+strconv.FormatInt(min, 10)+" and "+strconv.FormatInt(max, 10), "min", min, "max", max)
+		}
+		return Success()
+	}
+}
+func Int64Positive(v int64) ValidatorFunc {
+	return func() ValidationResult {
+		if v <= 0 {
+			return FailCode("number.positive", "must be > 0")
+		}
+		return Success()
+	}
+}
+func Int64MultipleOf(v, m int64) ValidatorFunc {
+	return func() ValidationResult {
+		if m == 0 || v%m != 0 {
+			return FailCode("number.multiple_of", "must be a multiple of "+strconv.FormatInt(m, 10), "of", m)
 		}
 		return Success()
 	}
@@ -112,7 +376,7 @@ func IntNonZero(v int) ValidatorFunc {
 func FloatMin(v, min float64) ValidatorFunc {
 	return func() ValidationResult {
 		if v < min {
-			return Fail("must be >= " + trimFloatZeros(min))
+			return FailCode("number.min", "must be >= "+trimFloatZeros(min), "min", min)
 		}
 		return Success()
 	}
@@ -120,7 +384,7 @@ func FloatMin(v, min float64) ValidatorFunc {
 func FloatMax(v, max float64) ValidatorFunc {
 	return func() ValidationResult {
 		if v > max {
-			return Fail("must be <= " + trimFloatZeros(max))
+			return FailCode("number.max", "must be <= "+trimFloatZeros(max), "max", max)
 		}
 		return Success()
 	}
@@ -128,15 +392,48 @@ func FloatMax(v, max float64) ValidatorFunc {
 func FloatBetween(v, min, max float64) ValidatorFunc {
 	return func() ValidationResult {
 		if v < min || v > max {
-			return Fail("must be between " + trimFloatZeros(min) + " and " + trimFloatZeros(max))
+			return FailCode("number.between", "must be between "+trimFloatZeros(min)+" and "+trimFloatZeros(max), "min", min, "max", max)
 		}
 		return Success()
 	}
 }
+
+// IsPercentage validates a percentage in 0-100 inclusive.
+func IsPercentage(v float64) ValidatorFunc {
+	return percentageBetween(v, 100)
+}
+
+// IsPercentageFraction validates a percentage expressed as a fraction in
+// 0-1 inclusive.
+func IsPercentageFraction(v float64) ValidatorFunc {
+	return percentageBetween(v, 1)
+}
+
+// IsPercentageString validates a percentage in 0-100 written as a number
+// with an optional trailing "%", e.g. "12.5" or "12.5%".
+func IsPercentageString(s string) ValidatorFunc {
+	return func() ValidationResult {
+		v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil {
+			return FailCode("string.numeric", "must be numeric")
+		}
+		return IsPercentage(v)()
+	}
+}
+
+func percentageBetween(v, max float64) ValidatorFunc {
+	return func() ValidationResult {
+		if math.IsNaN(v) {
+			return FailCode("number.between", "must be between 0 and "+trimFloatZeros(max), "min", 0.0, "max", max)
+		}
+		return FloatBetween(v, 0, max)()
+	}
+}
+
 func FloatNonZero(v float64) ValidatorFunc {
 	return func() ValidationResult {
 		if v == 0 {
-			return Fail("must not be zero")
+			return FailCode("number.non_zero", "must not be zero")
 		}
 		return Success()
 	}
@@ -146,7 +443,7 @@ func FloatNonZero(v float64) ValidatorFunc {
 func IntPositive(v int) ValidatorFunc {
 	return func() ValidationResult {
 		if v <= 0 {
-			return Fail("must be > 0")
+			return FailCode("number.positive", "must be > 0")
 		}
 		return Success()
 	}
@@ -154,7 +451,7 @@ func IntPositive(v int) ValidatorFunc {
 func IntNonNegative(v int) ValidatorFunc {
 	return func() ValidationResult {
 		if v < 0 {
-			return Fail("must be >= 0")
+			return FailCode("number.non_negative", "must be >= 0")
 		}
 		return Success()
 	}
@@ -162,7 +459,7 @@ func IntNonNegative(v int) ValidatorFunc {
 func IntGreaterThan(v, min int) ValidatorFunc {
 	return func() ValidationResult {
 		if v <= min {
-			return Fail("must be > " + strconv.Itoa(min))
+			return FailCode("number.greater_than", "must be > "+strconv.Itoa(min), "min", min)
 		}
 		return Success()
 	}
@@ -170,7 +467,7 @@ func IntGreaterThan(v, min int) ValidatorFunc {
 func IntLessThan(v, max int) ValidatorFunc {
 	return func() ValidationResult {
 		if v >= max {
-			return Fail("must be < " + strconv.Itoa(max))
+			return FailCode("number.less_than", "must be < "+strconv.Itoa(max), "max", max)
 		}
 		return Success()
 	}
@@ -178,16 +475,42 @@ func IntLessThan(v, max int) ValidatorFunc {
 func IntMultipleOf(v, m int) ValidatorFunc {
 	return func() ValidationResult {
 		if m == 0 || v%m != 0 {
-			return Fail("must be a multiple of " + strconv.Itoa(m))
+			return FailCode("number.multiple_of", "must be a multiple of "+strconv.Itoa(m), "of", m)
+		}
+		return Success()
+	}
+}
+
+// IsPrime validates that v is a prime number, by trial division; use
+// IsProbablyPrimeBig for large values.
+func IsPrime(v int) ValidatorFunc {
+	return func() ValidationResult {
+		if !isPrime(v) {
+			return FailCode("number.prime", "must be prime")
 		}
 		return Success()
 	}
 }
 
+func isPrime(v int) bool {
+	if v < 2 {
+		return false
+	}
+	if v%2 == 0 {
+		return v == 2
+	}
+	for d := 3; d <= v/d; d += 2 {
+		if v%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func FloatGreaterThan(v, min float64) ValidatorFunc {
 	return func() ValidationResult {
 		if !(v > min) {
-			return Fail("must be > " + trimFloatZeros(min))
+			return FailCode("number.greater_than", "must be > "+trimFloatZeros(min), "min", min)
 		}
 		return Success()
 	}
@@ -195,7 +518,7 @@ func FloatGreaterThan(v, min float64) ValidatorFunc {
 func FloatLessThan(v, max float64) ValidatorFunc {
 	return func() ValidationResult {
 		if !(v < max) {
-			return Fail("must be < " + trimFloatZeros(max))
+			return FailCode("number.less_than", "must be < "+trimFloatZeros(max), "max", max)
 		}
 		return Success()
 	}
@@ -203,7 +526,7 @@ func FloatLessThan(v, max float64) ValidatorFunc {
 func FloatMultipleOf(v, m float64) ValidatorFunc {
 	return func() ValidationResult {
 		if m == 0 {
-			return Fail("must be a multiple of 0 is undefined")
+			return FailCode("number.multiple_of", "must be a multiple of 0 is undefined", "of", m)
 		}
 		q := v / m
 		qi := float64(int64(q))
@@ -212,7 +535,7 @@ func FloatMultipleOf(v, m float64) ValidatorFunc {
 			r = -r
 		}
 		if r > 1e-9 {
-			return Fail("must be a multiple of " + trimFloatZeros(m))
+			return FailCode("number.multiple_of", "must be a multiple of "+trimFloatZeros(m), "of", m)
 		}
 		return Success()
 	}
@@ -222,7 +545,7 @@ func FloatMultipleOf(v, m float64) ValidatorFunc {
 func TimeNotZero(t time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		if t.IsZero() {
-			return Fail("must not be zero time")
+			return FailCode("time.not_zero", "must not be zero time")
 		}
 		return Success()
 	}
@@ -230,7 +553,7 @@ func TimeNotZero(t time.Time) ValidatorFunc {
 func TimeBefore(t, cutoff time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		if !t.Before(cutoff) {
-			return Fail("must be before cutoff")
+			return FailCode("time.before", "must be before cutoff", "cutoff", cutoff)
 		}
 		return Success()
 	}
@@ -238,7 +561,7 @@ func TimeBefore(t, cutoff time.Time) ValidatorFunc {
 func TimeAfter(t, cutoff time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		if !t.After(cutoff) {
-			return Fail("must be after cutoff")
+			return FailCode("time.after", "must be after cutoff", "cutoff", cutoff)
 		}
 		return Success()
 	}
@@ -246,7 +569,7 @@ func TimeAfter(t, cutoff time.Time) ValidatorFunc {
 func TimeBetween(t, start, end time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		if t.Before(start) || t.After(end) {
-			return Fail("must be between start and end")
+			return FailCode("time.between", "must be between start and end", "start", start, "end", end)
 		}
 		return Success()
 	}
@@ -256,7 +579,7 @@ func TimeBetween(t, start, end time.Time) ValidatorFunc {
 func InPast(t time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		if !t.Before(time.Now()) {
-			return Fail("must be in the past")
+			return FailCode("time.in_past", "must be in the past")
 		}
 		return Success()
 	}
@@ -264,7 +587,7 @@ func InPast(t time.Time) ValidatorFunc {
 func InFuture(t time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		if !t.After(time.Now()) {
-			return Fail("must be in the future")
+			return FailCode("time.in_future", "must be in the future")
 		}
 		return Success()
 	}
@@ -273,7 +596,7 @@ func IsWeekday(t time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		wd := t.Weekday()
 		if wd == time.Saturday || wd == time.Sunday {
-			return Fail("must be a weekday")
+			return FailCode("time.weekday", "must be a weekday")
 		}
 		return Success()
 	}
@@ -282,207 +605,786 @@ func IsWeekend(t time.Time) ValidatorFunc {
 	return func() ValidationResult {
 		wd := t.Weekday()
 		if wd != time.Saturday && wd != time.Sunday {
-			return Fail("must be a weekend day")
+			return FailCode("time.weekend", "must be a weekend day")
 		}
 		return Success()
 	}
 }
 
-// Duration rules
-func DurationMin(d, min time.Duration) ValidatorFunc {
+// SameDay validates that a and b fall on the same calendar day in loc (UTC when nil).
+func SameDay(a, b time.Time, loc *time.Location) ValidatorFunc {
 	return func() ValidationResult {
-		if d < min {
-			return Fail("duration too small: min " + min.String())
+		tz := loc
+		if tz == nil {
+			tz = time.UTC
 		}
-		return Success()
-	}
-}
-func DurationMax(d, max time.Duration) ValidatorFunc {
-	return func() ValidationResult {
-		if d > max {
-			return Fail("duration too large: max " + max.String())
+		ay, am, ad := a.In(tz).Date()
+		by, bm, bd := b.In(tz).Date()
+		if ay != by || am != bm || ad != bd {
+			return FailCode("time.same_day", "must be the same day")
 		}
 		return Success()
 	}
 }
 
-// Collection rules (length-based via explicit length parameter)
-func NotEmptyLen(n int) ValidatorFunc {
+// SameMonth validates that a and b fall in the same calendar month in loc (UTC when nil).
+func SameMonth(a, b time.Time, loc *time.Location) ValidatorFunc {
 	return func() ValidationResult {
-		if n == 0 {
-			return Fail("must not be empty")
+		tz := loc
+		if tz == nil {
+			tz = time.UTC
 		}
-		return Success()
-	}
-}
-func LenMin(n, min int) ValidatorFunc {
-	return func() ValidationResult {
-		if n < min {
-			return Fail("size too small: min " + strconv.Itoa(min))
+		ay, am, _ := a.In(tz).Date()
+		by, bm, _ := b.In(tz).Date()
+		if ay != by || am != bm {
+			return FailCode("time.same_month", "must be the same month")
 		}
 		return Success()
 	}
 }
-func LenMax(n, max int) ValidatorFunc {
+
+// TimeInBusinessHours validates that t falls on Monday–Friday with its local
+// hour in [openHour, closeHour). A closeHour of 24 covers the rest of the day.
+func TimeInBusinessHours(t time.Time, openHour, closeHour int, loc *time.Location) ValidatorFunc {
 	return func() ValidationResult {
-		if n > max {
-			return Fail("size too large: max " + strconv.Itoa(max))
+		tz := loc
+		if tz == nil {
+			tz = time.UTC
+		}
+		lt := t.In(tz)
+		wd := lt.Weekday()
+		h := lt.Hour()
+		if wd == time.Saturday || wd == time.Sunday || h < openHour || h >= closeHour {
+			return FailCode("time.business_hours", "must be within business hours", "open", openHour, "close", closeHour)
 		}
 		return Success()
 	}
 }
-func LenBetweenSize(n, min, max int) ValidatorFunc {
+
+// Time string rules
+
+// IsRFC3339 validates an RFC 3339 timestamp; fractional seconds are accepted.
+func IsRFC3339(s string) ValidatorFunc {
 	return func() ValidationResult {
-		if n < min || n > max {
-			return Fail("size must be between " + strconv.Itoa(min) + " and " + strconv.Itoa(max))
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return FailCode("time.rfc3339", "must be an RFC3339 timestamp")
 		}
 		return Success()
 	}
 }
 
-func ContainsString(list []string, elem string) ValidatorFunc {
+// IsDateOnly validates a calendar date in the form 2006-01-02.
+func IsDateOnly(s string) ValidatorFunc {
 	return func() ValidationResult {
-		for _, v := range list {
-			if v == elem {
-				return Success()
-			}
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return FailCode("time.date_only", "must be a date (YYYY-MM-DD)")
 		}
-		return Fail("must contain " + elem)
+		return Success()
 	}
 }
 
-func UniqueStrings(list []string) ValidatorFunc {
+// IsTimeFormat validates that s parses with the given Go time layout.
+func IsTimeFormat(s, layout string) ValidatorFunc {
 	return func() ValidationResult {
-		seen := make(map[string]struct{}, len(list))
-		for _, v := range list {
-			if _, ok := seen[v]; ok {
-				return Fail("must be unique")
-			}
-			seen[v] = struct{}{}
+		if _, err := time.Parse(layout, s); err != nil {
+			return FailCode("time.format", "must match time format "+layout, "layout", layout)
 		}
 		return Success()
 	}
 }
 
-// Email and phone
-var reEmailLight = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
-var reE164 = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+// loadedZones caches zone names that time.LoadLocation accepted.
+var loadedZones sync.Map
 
-func EmailValid(s string) ValidatorFunc {
+// IsTimezone validates an IANA time zone name such as "America/New_York";
+// "UTC" and "Local" are accepted too, the empty string is not (LoadLocation
+// maps it to UTC). It relies on time.LoadLocation and so
+// on the tz database of the host; binaries for hosts without one should
+// import time/tzdata. Successful lookups are cached.
+func IsTimezone(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if s == "" {
-			return Fail("must not be empty")
+			return FailCode("time.timezone", "must be a valid IANA timezone")
 		}
-		if !reEmailLight.MatchString(s) {
-			return Fail("invalid email")
+		if _, ok := loadedZones.Load(s); ok {
+			return Success()
 		}
+		if _, err := time.LoadLocation(s); err != nil {
+			return FailCode("time.timezone", "must be a valid IANA timezone")
+		}
+		loadedZones.Store(s, struct{}{})
 		return Success()
 	}
 }
 
-func PhoneE164(s string) ValidatorFunc {
+// Duration rules
+func DurationMin(d, min time.Duration) ValidatorFunc {
 	return func() ValidationResult {
-		if !reE164.MatchString(s) {
-			return Fail("invalid phone (use E.164, e.g. +15551234567)")
+		if d < min {
+			return FailCode("duration.min", "duration too small: min "+min.String(), "min", min)
 		}
 		return Success()
 	}
 }
-
-// PhoneWithCountryCode validates E.164 phone number with a required country code prefix, e.g., "+251".
-func PhoneWithCountryCode(s string, countryCode string) ValidatorFunc {
+func DurationMax(d, max time.Duration) ValidatorFunc {
 	return func() ValidationResult {
-		if !strings.HasPrefix(s, countryCode) {
-			return Fail("invalid phone: must start with " + countryCode)
-		}
-		if !reE164.MatchString(s) {
-			return Fail("invalid phone (use E.164, e.g. +15551234567)")
+		if d > max {
+			return FailCode("duration.max", "duration too large: max "+max.String(), "max", max)
 		}
 		return Success()
 	}
 }
-
-// Additional string classifiers
-func HasPrefix(s, prefix string) ValidatorFunc {
+func DurationPositive(d time.Duration) ValidatorFunc {
 	return func() ValidationResult {
-		if !strings.HasPrefix(s, prefix) {
-			return Fail("must start with " + prefix)
+		if d <= 0 {
+			return FailCode("duration.positive", "duration must be > 0")
 		}
 		return Success()
 	}
 }
-func HasSuffix(s, suffix string) ValidatorFunc {
+func DurationNonZero(d time.Duration) ValidatorFunc {
 	return func() ValidationResult {
-		if !strings.HasSuffix(s, suffix) {
-			return Fail("must end with " + suffix)
+		if d == 0 {
+			return FailCode("duration.non_zero", "duration must not be zero")
 		}
 		return Success()
 	}
 }
-func Contains(s, substr string) ValidatorFunc {
+func DurationBetween(d, min, max time.Duration) ValidatorFunc {
 	return func() ValidationResult {
-		if !strings.Contains(s, substr) {
-			return Fail("must contain " + substr)
+		if d < min || d > max {
+			return FailCode("duration.between", "duration must be between "+min.String()+" and "+max.String(), "min", min, "max", max)
 		}
 		return Success()
 	}
 }
-func Trimmed(s string) ValidatorFunc {
+
+// IsDurationString validates that s parses with time.ParseDuration, e.g.
+// "1h30m" or "-5s". The empty string fails.
+func IsDurationString(s string) ValidatorFunc {
 	return func() ValidationResult {
-		if strings.TrimSpace(s) != s {
-			return Fail("must not have leading/trailing spaces")
+		if _, err := time.ParseDuration(s); err != nil {
+			return FailCode("duration.format", "must be a duration (e.g. 1h30m)")
 		}
 		return Success()
 	}
 }
-func IsAlpha(s string) ValidatorFunc {
+
+// DurationStringBetween parses s as a duration and validates it with
+// DurationBetween.
+func DurationStringBetween(s string, min, max time.Duration) ValidatorFunc {
 	return func() ValidationResult {
-		for _, r := range s {
-			if !unicode.IsLetter(r) {
-				return Fail("must contain only letters")
-			}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return FailCode("duration.format", "must be a duration (e.g. 1h30m)")
 		}
-		return Success()
+		return DurationBetween(d, min, max)()
 	}
 }
-func IsNumeric(s string) ValidatorFunc {
+
+// Collection rules (length-based via explicit length parameter)
+func NotEmptyLen(n int) ValidatorFunc {
 	return func() ValidationResult {
-		if s == "" {
-			return Fail("must be numeric")
+		if n == 0 {
+			return FailCode("collection.non_empty", "must not be empty")
+		}
+		return Success()
+	}
+}
+func LenMin(n, min int) ValidatorFunc {
+	return func() ValidationResult {
+		if n < min {
+			return FailCode("collection.min_len", "size too small: min "+strconv.Itoa(min), "min", min)
+		}
+		return Success()
+	}
+}
+func LenMax(n, max int) ValidatorFunc {
+	return func() ValidationResult {
+		if n > max {
+			return FailCode("collection.max_len", "size too large: max "+strconv.Itoa(max), "max", max)
+		}
+		return Success()
+	}
+}
+func LenBetweenSize(n, min, max int) ValidatorFunc {
+	return func() ValidationResult {
+		if n < min || n > max {
+			return FailCode("collection.len_between", "size must be between "+strconv.Itoa(min)+" and "+strconv.Itoa(max), "min", min, "max", max)
+		}
+		return Success()
+	}
+}
+
+// Typed collection rules; they share the wording of the length-based rules.
+func NotEmptySlice[T any](s []T) ValidatorFunc {
+	return NotEmptyLen(len(s))
+}
+func NotEmptyMap[K comparable, V any](m map[K]V) ValidatorFunc {
+	return NotEmptyLen(len(m))
+}
+
+// MapHasKeys validates that m contains every key in keys; the message lists
+// the missing ones in the order given.
+func MapHasKeys[K comparable, V any](m map[K]V, keys ...K) ValidatorFunc {
+	return func() ValidationResult {
+		var missing []string
+		for _, k := range keys {
+			if _, ok := m[k]; !ok {
+				missing = append(missing, fmt.Sprint(k))
+			}
+		}
+		if len(missing) > 0 {
+			return FailCode("collection.missing_keys", "missing keys: "+strings.Join(missing, ", "), "keys", missing)
+		}
+		return Success()
+	}
+}
+
+// MapKeysOneOf validates that every key of m is in allowed; the message
+// lists the unknown keys, sorted.
+func MapKeysOneOf[K comparable, V any](m map[K]V, allowed []K) ValidatorFunc {
+	return func() ValidationResult {
+		ok := make(map[K]struct{}, len(allowed))
+		for _, k := range allowed {
+			ok[k] = struct{}{}
+		}
+		var unknown []string
+		for k := range m {
+			if _, found := ok[k]; !found {
+				unknown = append(unknown, fmt.Sprint(k))
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return FailCode("collection.unknown_keys", "unknown keys: "+strings.Join(unknown, ", "), "keys", unknown)
+		}
+		return Success()
+	}
+}
+
+func SliceLenBetween[T any](s []T, min, max int) ValidatorFunc {
+	return LenBetweenSize(len(s), min, max)
+}
+
+func ContainsString(list []string, elem string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, v := range list {
+			if v == elem {
+				return Success()
+			}
+		}
+		return FailCode("collection.contains", "must contain "+elem, "elem", elem)
+	}
+}
+
+func UniqueStrings(list []string) ValidatorFunc {
+	return func() ValidationResult {
+		seen := make(map[string]struct{}, len(list))
+		for _, v := range list {
+			if _, ok := seen[v]; ok {
+				return FailCode("collection.unique", "must be unique")
+			}
+			seen[v] = struct{}{}
+		}
+		return Success()
+	}
+}
+
+// ContainsStringFold is ContainsString compared case-insensitively.
+func ContainsStringFold(list []string, elem string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, v := range list {
+			if strings.EqualFold(v, elem) {
+				return Success()
+			}
+		}
+		return FailCode("collection.contains", "must contain "+elem, "elem", elem)
+	}
+}
+
+// UniqueStringsFold is UniqueStrings with case-insensitive comparison, so
+// "Foo" and "foo" are duplicates.
+func UniqueStringsFold(list []string) ValidatorFunc {
+	return func() ValidationResult {
+		seen := make(map[string]struct{}, len(list))
+		for _, v := range list {
+			k := foldKey(v)
+			if _, ok := seen[k]; ok {
+				return FailCode("collection.unique", "must be unique")
+			}
+			seen[k] = struct{}{}
+		}
+		return Success()
+	}
+}
+
+// foldKey maps strings that are equal under strings.EqualFold to the same
+// key for the common cases.
+func foldKey(s string) string {
+	return strings.ToLower(strings.ToUpper(s))
+}
+
+// Email and phone
+var reEmailLight = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+var reE164 = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+
+func EmailValid(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s == "" {
+			return FailCode("string.non_empty", "must not be empty")
+		}
+		if !reEmailLight.MatchString(s) {
+			return FailCode("email.invalid", "invalid email")
+		}
+		return Success()
+	}
+}
+
+func PhoneE164(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if !reE164.MatchString(s) {
+			return FailCode("phone.e164", "invalid phone (use E.164, e.g. +15551234567)")
+		}
+		return Success()
+	}
+}
+
+// PhoneWithCountryCode validates E.164 phone number with a required country code prefix, e.g., "+251".
+func PhoneWithCountryCode(s string, countryCode string) ValidatorFunc {
+	return func() ValidationResult {
+		if !strings.HasPrefix(s, countryCode) {
+			return FailCode("phone.country_code", "invalid phone: must start with "+countryCode, "code", countryCode)
+		}
+		if !reE164.MatchString(s) {
+			return FailCode("phone.e164", "invalid phone (use E.164, e.g. +15551234567)")
+		}
+		return Success()
+	}
+}
+
+// Additional string classifiers
+func HasPrefix(s, prefix string) ValidatorFunc {
+	return func() ValidationResult {
+		if !strings.HasPrefix(s, prefix) {
+			return FailCode("string.has_prefix", "must start with "+prefix, "prefix", prefix)
+		}
+		return Success()
+	}
+}
+func HasSuffix(s, suffix string) ValidatorFunc {
+	return func() ValidationResult {
+		if !strings.HasSuffix(s, suffix) {
+			return FailCode("string.has_suffix", "must end with "+suffix, "suffix", suffix)
+		}
+		return Success()
+	}
+}
+
+// StartsWithAny validates that s starts with at least one of prefixes.
+// An empty prefix list always fails.
+func StartsWithAny(s string, prefixes []string) ValidatorFunc {
+	return func() ValidationResult {
+		if len(prefixes) == 0 {
+			return FailCode("string.has_prefix_any", "no allowed prefixes configured")
+		}
+		for _, p := range prefixes {
+			if strings.HasPrefix(s, p) {
+				return Success()
+			}
+		}
+		return FailCode("string.has_prefix_any", "must start with one of: "+strings.Join(prefixes, ", "), "prefixes", prefixes)
+	}
+}
+
+// EndsWithAny validates that s ends with at least one of suffixes.
+// An empty suffix list always fails.
+func EndsWithAny(s string, suffixes []string) ValidatorFunc {
+	return func() ValidationResult {
+		if len(suffixes) == 0 {
+			return FailCode("string.has_suffix_any", "no allowed suffixes configured")
+		}
+		for _, sfx := range suffixes {
+			if strings.HasSuffix(s, sfx) {
+				return Success()
+			}
+		}
+		return FailCode("string.has_suffix_any", "must end with one of: "+strings.Join(suffixes, ", "), "suffixes", suffixes)
+	}
+}
+func Contains(s, substr string) ValidatorFunc {
+	return func() ValidationResult {
+		if !strings.Contains(s, substr) {
+			return FailCode("string.contains", "must contain "+substr, "substr", substr)
+		}
+		return Success()
+	}
+}
+
+// ContainsAny validates that s contains at least one of subs.
+func ContainsAny(s string, subs []string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, sub := range subs {
+			if strings.Contains(s, sub) {
+				return Success()
+			}
+		}
+		return FailCode("string.contains_any", "must contain one of: "+strings.Join(subs, ", "), "subs", subs)
+	}
+}
+
+// ContainsNone validates that s contains none of subs, naming the first
+// forbidden substring (in list order) that was found.
+func ContainsNone(s string, subs []string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, sub := range subs {
+			if strings.Contains(s, sub) {
+				return FailCode("string.contains_none", "must not contain "+sub, "substr", sub)
+			}
+		}
+		return Success()
+	}
+}
+func Trimmed(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if strings.TrimSpace(s) != s {
+			return FailCode("string.trimmed", "must not have leading/trailing spaces")
+		}
+		return Success()
+	}
+}
+
+// Word rules count runs of non-whitespace separated by Unicode whitespace,
+// so punctuation-only tokens such as "-" count as words.
+func MinWords(s string, n int) ValidatorFunc {
+	return func() ValidationResult {
+		if len(strings.Fields(s)) < n {
+			return FailCode("string.min_words", "must have at least "+strconv.Itoa(n)+" words", "min", n)
+		}
+		return Success()
+	}
+}
+func MaxWords(s string, n int) ValidatorFunc {
+	return func() ValidationResult {
+		if len(strings.Fields(s)) > n {
+			return FailCode("string.max_words", "must have at most "+strconv.Itoa(n)+" words", "max", n)
+		}
+		return Success()
+	}
+}
+func IsAlpha(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, r := range s {
+			if !unicode.IsLetter(r) {
+				return FailCode("string.alpha", "must contain only letters")
+			}
+		}
+		return Success()
+	}
+}
+
+// IsNumeric validates that s is a non-empty run of decimal digits
+// (unicode.IsDigit). That includes non-ASCII digits such as fullwidth
+// "１２３" or Arabic-Indic "٤٢"; combine with IsASCII to require 0-9.
+func IsNumeric(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s == "" {
+			return FailCode("string.numeric", "must be numeric")
+		}
+		for _, r := range s {
+			if !unicode.IsDigit(r) {
+				return FailCode("string.numeric", "must be numeric")
+			}
+		}
+		return Success()
+	}
+}
+
+// IsNumericUnicode is like IsNumeric but accepts any Unicode number
+// (unicode.IsNumber), including letter-like numerals such as Roman "Ⅻ" and
+// other forms like "½" or "²" that IsNumeric rejects.
+func IsNumericUnicode(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s == "" {
+			return FailCode("string.numeric", "must be numeric")
+		}
+		for _, r := range s {
+			if !unicode.IsNumber(r) {
+				return FailCode("string.numeric", "must be numeric")
+			}
+		}
+		return Success()
+	}
+}
+
+// IsAlphaSpace is like IsAlpha but also allows spaces, e.g. for names such
+// as "José María". Letters are any Unicode letters, not only ASCII.
+func IsAlphaSpace(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, r := range s {
+			if !unicode.IsLetter(r) && r != ' ' {
+				return FailCode("string.alpha_space", "must contain only letters and spaces")
+			}
+		}
+		return Success()
+	}
+}
+func IsAlnum(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, r := range s {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				return FailCode("string.alnum", "must be alphanumeric")
+			}
+		}
+		return Success()
+	}
+}
+
+// Case rules compare against Go's simple per-rune case mapping
+// (strings.ToLower/ToUpper) without language-specific special casing.
+// Characters without a single-rune counterpart, such as 'ß', map to
+// themselves and therefore satisfy both IsLowercase and IsUppercase.
+// Empty strings pass.
+func IsLowercase(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s != strings.ToLower(s) {
+			return FailCode("string.lowercase", "must be lowercase")
+		}
+		return Success()
+	}
+}
+func IsUppercase(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s != strings.ToUpper(s) {
+			return FailCode("string.uppercase", "must be uppercase")
+		}
+		return Success()
+	}
+}
+
+// IsTitleCase validates that every whitespace-separated word starts with an
+// upper- or title-case letter (if it starts with a letter) and that the rest
+// of the word is lowercase.
+func IsTitleCase(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for _, w := range strings.Fields(s) {
+			r, size := utf8.DecodeRuneInString(w)
+			rest := w[size:]
+			if unicode.IsLower(r) || rest != strings.ToLower(rest) {
+				return FailCode("string.title_case", "must be title case")
+			}
+		}
+		return Success()
+	}
+}
+
+// IsASCII validates that every rune of s is in the 7-bit ASCII range.
+func IsASCII(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for i := 0; i < len(s); i++ {
+			if s[i] > unicode.MaxASCII {
+				return FailCode("string.ascii", "must be ASCII")
+			}
+		}
+		return Success()
+	}
+}
+
+// IsPrintableASCII is like IsASCII but also rejects control characters
+// (below 0x20 and 0x7f).
+func IsPrintableASCII(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for i := 0; i < len(s); i++ {
+			if s[i] < 0x20 || s[i] >= 0x7f {
+				return FailCode("string.printable_ascii", "must be printable ASCII")
+			}
+		}
+		return Success()
+	}
+}
+
+var reHex = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+
+func IsHex(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if !reHex.MatchString(s) {
+			return FailCode("string.hex", "must be hex")
+		}
+		return Success()
+	}
+}
+
+// IsHexPrefixed validates "0x" or "0X" followed by at least one hex digit,
+// as used for Ethereum-style values.
+func IsHexPrefixed(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if len(s) < 3 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') || !reHex.MatchString(s[2:]) {
+			return FailCode("string.hex_prefixed", "must be 0x-prefixed hex")
+		}
+		return Success()
+	}
+}
+
+// IsHexBytes validates hex encoding exactly n bytes (2n digits), with an
+// optional "0x"/"0X" prefix, e.g. n = 20 for an address or 32 for a hash.
+func IsHexBytes(s string, n int) ValidatorFunc {
+	return func() ValidationResult {
+		digits := s
+		if len(digits) >= 2 && digits[0] == '0' && (digits[1] == 'x' || digits[1] == 'X') {
+			digits = digits[2:]
+		}
+		if digits != "" && !reHex.MatchString(digits) {
+			return FailCode("string.hex", "must be hex")
+		}
+		if len(digits) != 2*n {
+			return FailCode("string.hex_bytes", "must be "+strconv.Itoa(n)+" bytes", "bytes", n)
+		}
+		return Success()
+	}
+}
+
+// IsHexColorDigits validates a color written as 3, 6 or 8 hex digits
+// without a leading "#", e.g. "fff" or "ff8800cc".
+func IsHexColorDigits(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if (len(s) != 3 && len(s) != 6 && len(s) != 8) || !reHex.MatchString(s) {
+			return FailCode("string.hex_color", "must be a hex color")
+		}
+		return Success()
+	}
+}
+func IsBase64(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+			return FailCode("string.base64", "must be base64")
+		}
+		return Success()
+	}
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// IsBase58 validates a non-empty string in the Bitcoin Base58 alphabet,
+// which excludes the ambiguous characters 0, O, I and l.
+func IsBase58(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s == "" || strings.Trim(s, base58Alphabet) != "" {
+			return FailCode("string.base58", "must be base58")
+		}
+		return Success()
+	}
+}
+
+// IsMIMEType validates a media type of the form type/subtype with optional
+// parameters, e.g. "text/html; charset=utf-8".
+func IsMIMEType(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if !validMediaType(s) {
+			return FailCode("string.mime_type", "must be a MIME type")
+		}
+		return Success()
+	}
+}
+
+func validMediaType(s string) bool {
+	mt, _, err := mime.ParseMediaType(s)
+	if err != nil {
+		return false
+	}
+	slash := strings.IndexByte(mt, '/')
+	return slash > 0 && slash < len(mt)-1
+}
+
+// IsDataURI validates an RFC 2397 data URI, data:[<mime>][;base64],<data>,
+// including decoding the payload when it is base64 encoded.
+func IsDataURI(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if len(s) < 5 || !strings.EqualFold(s[:5], "data:") {
+			return FailCode("string.data_uri", "must be a data URI")
 		}
-		for _, r := range s {
-			if !unicode.IsDigit(r) {
-				return Fail("must be numeric")
+		comma := strings.IndexByte(s, ',')
+		if comma == -1 {
+			return FailCode("string.data_uri", "must be a data URI")
+		}
+		meta, data := s[5:comma], s[comma+1:]
+		isBase64 := false
+		if len(meta) >= 7 && strings.EqualFold(meta[len(meta)-7:], ";base64") {
+			isBase64 = true
+			meta = meta[:len(meta)-7]
+		}
+		if strings.HasPrefix(meta, ";") {
+			// Parameters without a type default to text/plain
+			meta = "text/plain" + meta
+		}
+		if meta != "" && !validMediaType(meta) {
+			return FailCode("string.data_uri", "data URI has an invalid media type")
+		}
+		if isBase64 {
+			if _, err := base64.StdEncoding.DecodeString(data); err != nil {
+				return FailCode("string.data_uri", "data URI has an invalid base64 payload")
 			}
+		} else if _, err := url.PathUnescape(data); err != nil {
+			return FailCode("string.data_uri", "data URI has an invalid payload")
 		}
 		return Success()
 	}
 }
-func IsAlnum(s string) ValidatorFunc {
+
+// IsCleanRelPath validates a user-supplied relative path that stays below
+// the directory it is resolved against: after filepath.Clean it must not be
+// absolute, empty, or start with "..". Separators follow the host OS, as
+// for filepath.IsLocal.
+func IsCleanRelPath(s string) ValidatorFunc {
 	return func() ValidationResult {
-		for _, r := range s {
-			if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
-				return Fail("must be alphanumeric")
-			}
+		if !filepath.IsLocal(s) {
+			return FailCode("path.escape", "path must be relative and not escape the root")
 		}
 		return Success()
 	}
 }
 
-var reHex = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+// HasExtension validates that the file extension of s is one of exts,
+// compared case-insensitively; the leading dot is optional, so "png" and
+// ".png" are equivalent.
+func HasExtension(s string, exts ...string) ValidatorFunc {
+	return func() ValidationResult {
+		ext := filepath.Ext(s)
+		allowed := make([]string, len(exts))
+		for i, e := range exts {
+			if !strings.HasPrefix(e, ".") {
+				e = "." + e
+			}
+			if ext != "" && strings.EqualFold(ext, e) {
+				return Success()
+			}
+			allowed[i] = e
+		}
+		return FailCode("path.extension", "extension must be one of: "+strings.Join(allowed, ", "), "allowed", allowed)
+	}
+}
 
-func IsHex(s string) ValidatorFunc {
+var (
+	reEnvVarName        = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+	reEnvVarNameLenient = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// IsEnvVarName validates a POSIX-style environment variable name: upper
+// case letters, digits and underscores, not starting with a digit.
+func IsEnvVarName(s string) ValidatorFunc {
 	return func() ValidationResult {
-		if !reHex.MatchString(s) {
-			return Fail("must be hex")
+		if !reEnvVarName.MatchString(s) {
+			return FailCode("string.env_var", "invalid environment variable name")
 		}
 		return Success()
 	}
 }
-func IsBase64(s string) ValidatorFunc {
+
+// IsEnvVarNameLenient is like IsEnvVarName but also allows lower case
+// letters, as most shells do.
+func IsEnvVarNameLenient(s string) ValidatorFunc {
 	return func() ValidationResult {
-		if _, err := base64.StdEncoding.DecodeString(s); err != nil {
-			return Fail("must be base64")
+		if !reEnvVarNameLenient.MatchString(s) {
+			return FailCode("string.env_var", "invalid environment variable name")
 		}
 		return Success()
 	}
@@ -493,7 +1395,21 @@ var reSlug = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 func IsSlug(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if !reSlug.MatchString(s) {
-			return Fail("must be a slug")
+			return FailCode("string.slug", "must be a slug")
+		}
+		return Success()
+	}
+}
+
+var reSlugUnicode = regexp.MustCompile(`^[\p{Ll}\p{Lm}\p{Lo}\p{Mn}\p{Nd}]+(?:-[\p{Ll}\p{Lm}\p{Lo}\p{Mn}\p{Nd}]+)*$`)
+
+// IsSlugUnicode is like IsSlug but allows any lowercase or uncased Unicode
+// letter and any decimal digit, e.g. "café-crème" or "東京-2024". Words are
+// separated by single hyphens.
+func IsSlugUnicode(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if !reSlugUnicode.MatchString(s) {
+			return FailCode("string.slug", "must be a slug")
 		}
 		return Success()
 	}
@@ -504,7 +1420,46 @@ var reUUIDv4 = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89
 func IsUUIDv4(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if !reUUIDv4.MatchString(s) {
-			return Fail("must be UUID v4")
+			return FailCode("string.uuid_v4", "must be UUID v4")
+		}
+		return Success()
+	}
+}
+
+var reUUID = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[1-8][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// nilUUID is the all-zero UUID. Its version nibble (first digit of the
+// third group) and variant bits (top bits of the fourth group) are zero
+// too, so it matches neither a version nor the RFC 4122 variant.
+const nilUUID = "00000000-0000-0000-0000-000000000000"
+
+// UUIDOpts configures IsUUID.
+type UUIDOpts struct {
+	AllowNil bool // accept the nil UUID
+}
+
+// IsUUID validates a UUID of any version from 1 to 8 with the RFC 4122
+// variant. The nil UUID is rejected unless opts.AllowNil is set.
+func IsUUID(s string, opts UUIDOpts) ValidatorFunc {
+	return func() ValidationResult {
+		if s == nilUUID {
+			if opts.AllowNil {
+				return Success()
+			}
+			return FailCode("string.uuid_not_nil", "must not be nil UUID")
+		}
+		if !reUUID.MatchString(s) {
+			return FailCode("string.uuid", "must be UUID")
+		}
+		return Success()
+	}
+}
+
+// IsNilUUID validates that s is the nil UUID.
+func IsNilUUID(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if s != nilUUID {
+			return FailCode("string.uuid_nil", "must be nil UUID")
 		}
 		return Success()
 	}
@@ -515,7 +1470,19 @@ var reULID = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`)
 func IsULID(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if !reULID.MatchString(s) {
-			return Fail("must be ULID")
+			return FailCode("string.ulid", "must be ULID")
+		}
+		return Success()
+	}
+}
+
+var reMongoObjectID = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+
+// IsMongoObjectID validates a MongoDB ObjectID: exactly 24 hex characters.
+func IsMongoObjectID(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if !reMongoObjectID.MatchString(s) {
+			return FailCode("string.mongo_object_id", "must be a Mongo ObjectID")
 		}
 		return Success()
 	}
@@ -526,7 +1493,88 @@ func IsURL(s string) ValidatorFunc {
 	return func() ValidationResult {
 		u, err := url.Parse(s)
 		if err != nil || u.Scheme == "" || u.Host == "" {
-			return Fail("must be URL")
+			return FailCode("net.url", "must be URL")
+		}
+		return Success()
+	}
+}
+
+// IsAbsoluteURL validates a URL with a scheme and a host, like IsURL, but
+// with a message that tells it apart from IsRelativeURL.
+func IsAbsoluteURL(s string) ValidatorFunc {
+	return func() ValidationResult {
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return FailCode("net.url_absolute", "must be an absolute URL")
+		}
+		return Success()
+	}
+}
+
+// IsRelativeURL validates a URL reference without scheme or host, such as
+// "/path?q=1" or "../img.png", for link fields that stay on the same site.
+// The path must be non-empty; protocol-relative "//host/path" is rejected,
+// as is any backslash, since browsers read "/\host" and "\\host" as
+// "//host".
+func IsRelativeURL(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if strings.ContainsRune(s, '\\') {
+			return FailCode("net.url_relative", "must be a relative URL")
+		}
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" {
+			return FailCode("net.url_relative", "must be a relative URL")
+		}
+		return Success()
+	}
+}
+
+// IsURLWithSchemes validates a URL with a host whose scheme is one of
+// schemes, compared case-insensitively.
+func IsURLWithSchemes(s string, schemes ...string) ValidatorFunc {
+	return func() ValidationResult {
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" {
+			return FailCode("net.url", "must be URL")
+		}
+		allowed := false
+		for _, sc := range schemes {
+			if strings.EqualFold(u.Scheme, sc) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return FailCode("net.url_scheme", "URL scheme must be one of: "+strings.Join(schemes, ", "), "schemes", schemes)
+		}
+		if u.Host == "" {
+			return FailCode("net.url", "must be URL")
+		}
+		return Success()
+	}
+}
+
+// IsHTTPSURL validates a URL suitable for outbound calls such as webhooks:
+// the scheme must be https, the host must contain a dot (rejecting e.g.
+// "localhost") and the URL must not embed credentials.
+func IsHTTPSURL(s string) ValidatorFunc {
+	return func() ValidationResult {
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" {
+			return FailCode("net.url", "must be URL")
+		}
+		if !strings.EqualFold(u.Scheme, "https") {
+			return FailCode("net.url_https", "URL must use https")
+		}
+		if u.User != nil {
+			return FailCode("net.url_userinfo", "URL must not contain credentials")
+		}
+		host := strings.TrimSuffix(u.Hostname(), ".")
+		if host == "" {
+			return FailCode("net.url", "must be URL")
+		}
+		if !strings.Contains(host, ".") {
+			return FailCode("net.url_host", "URL host must be a domain name with a dot", "host", host)
 		}
 		return Success()
 	}
@@ -537,15 +1585,47 @@ var reHostname = regexp.MustCompile(`^(?i:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?)(
 func IsHostname(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if len(s) > 253 || !reHostname.MatchString(s) {
-			return Fail("must be hostname")
+			return FailCode("net.hostname", "must be hostname")
 		}
 		return Success()
 	}
 }
+
+// IsFQDN validates a fully qualified domain name: hostname labels, at least
+// two of them, and a non-numeric TLD of two or more characters. A trailing
+// dot is rejected; use IsFQDNTrailingDot to accept the absolute form.
+func IsFQDN(s string) ValidatorFunc {
+	return func() ValidationResult {
+		return fqdnCheck(s)
+	}
+}
+
+// IsFQDNTrailingDot is IsFQDN but also accepts a single trailing dot, as in
+// "example.com.".
+func IsFQDNTrailingDot(s string) ValidatorFunc {
+	return func() ValidationResult {
+		return fqdnCheck(strings.TrimSuffix(s, "."))
+	}
+}
+
+func fqdnCheck(s string) ValidationResult {
+	if len(s) > 253 || !reHostname.MatchString(s) {
+		return FailCode("net.fqdn", "must be a fully qualified domain name")
+	}
+	dot := strings.LastIndexByte(s, '.')
+	if dot == -1 {
+		return FailCode("net.fqdn", "must be a fully qualified domain name")
+	}
+	tld := s[dot+1:]
+	if len(tld) < 2 || strings.Trim(tld, "0123456789") == "" {
+		return FailCode("net.fqdn", "must be a fully qualified domain name")
+	}
+	return Success()
+}
 func IsIP(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if net.ParseIP(s) == nil {
-			return Fail("must be IP")
+			return FailCode("net.ip", "must be IP")
 		}
 		return Success()
 	}
@@ -554,7 +1634,7 @@ func IsIPv4(s string) ValidatorFunc {
 	return func() ValidationResult {
 		ip := net.ParseIP(s)
 		if ip == nil || ip.To4() == nil {
-			return Fail("must be IPv4")
+			return FailCode("net.ipv4", "must be IPv4")
 		}
 		return Success()
 	}
@@ -563,7 +1643,104 @@ func IsIPv6(s string) ValidatorFunc {
 	return func() ValidationResult {
 		ip := net.ParseIP(s)
 		if ip == nil || ip.To4() != nil {
-			return Fail("must be IPv6")
+			return FailCode("net.ipv6", "must be IPv6")
+		}
+		return Success()
+	}
+}
+
+// nonPublicNets lists reserved ranges not covered by the net.IP predicates.
+var nonPublicNets = parseCIDRs(
+	"0.0.0.0/8",       // "this network"
+	"100.64.0.0/10",   // carrier-grade NAT shared address space
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // TEST-NET-1
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // TEST-NET-2
+	"203.0.113.0/24",  // TEST-NET-3
+	"240.0.0.0/4",     // reserved, including the limited broadcast address
+	"64:ff9b:1::/48",  // local-use NAT64
+	"2001:db8::/32",   // documentation
+)
+
+// Prefixes of IPv6 addresses that carry an IPv4 address, which is reached
+// through them and so must be public too.
+var (
+	nat64Net     = parseCIDRs("64:ff9b::/96")[0] // well-known NAT64, IPv4 in the last 4 bytes
+	sixToFourNet = parseCIDRs("2002::/16")[0]    // 6to4, IPv4 in bytes 2-5
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+	return nets
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	if v4 := embeddedIPv4(ip); v4 != nil {
+		return isPublicIP(v4)
+	}
+	return true
+}
+
+// embeddedIPv4 returns the IPv4 address carried by a NAT64 or 6to4 address,
+// or nil for any other address.
+func embeddedIPv4(ip net.IP) net.IP {
+	if ip.To4() != nil || len(ip) != net.IPv6len {
+		return nil
+	}
+	switch {
+	case nat64Net.Contains(ip):
+		return net.IPv4(ip[12], ip[13], ip[14], ip[15])
+	case sixToFourNet.Contains(ip):
+		return net.IPv4(ip[2], ip[3], ip[4], ip[5])
+	}
+	return nil
+}
+
+// IsPublicIP validates that s is a globally routable IPv4 or IPv6 address,
+// rejecting private (RFC 1918, fc00::/7), loopback, link-local, multicast,
+// unspecified, documentation, benchmarking and other reserved addresses.
+// NAT64 and 6to4 addresses are judged by the IPv4 address they embed.
+// Useful for SSRF protection.
+func IsPublicIP(s string) ValidatorFunc {
+	return func() ValidationResult {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return FailCode("net.ip", "must be IP")
+		}
+		if !isPublicIP(ip) {
+			return FailCode("net.public_ip", "must be a public IP")
+		}
+		return Success()
+	}
+}
+
+// IsPrivateIP is the complement of IsPublicIP for valid addresses.
+func IsPrivateIP(s string) ValidatorFunc {
+	return func() ValidationResult {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return FailCode("net.ip", "must be IP")
+		}
+		if isPublicIP(ip) {
+			return FailCode("net.private_ip", "must be a private IP")
 		}
 		return Success()
 	}
@@ -571,18 +1748,131 @@ func IsIPv6(s string) ValidatorFunc {
 func IsCIDR(s string) ValidatorFunc {
 	return func() ValidationResult {
 		if _, _, err := net.ParseCIDR(s); err != nil {
-			return Fail("must be CIDR")
+			return FailCode("net.cidr", "must be CIDR")
+		}
+		return Success()
+	}
+}
+
+// IPInCIDR validates that ip lies within the network cidr. Malformed inputs
+// and an IPv4/IPv6 family mismatch fail with their own messages.
+func IPInCIDR(ip, cidr string) ValidatorFunc {
+	return func() ValidationResult {
+		addr := net.ParseIP(ip)
+		if addr == nil {
+			return FailCode("net.ip", "must be IP")
+		}
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return FailCode("net.cidr", "must be CIDR")
+		}
+		if (addr.To4() != nil) != (n.IP.To4() != nil) {
+			return FailCode("net.ip_family", "IP family does not match CIDR", "cidr", cidr)
+		}
+		if !n.Contains(addr) {
+			return FailCode("net.ip_in_cidr", "IP not in CIDR range", "cidr", cidr)
+		}
+		return Success()
+	}
+}
+
+// IPInAnyCIDR validates that ip lies within at least one network of an
+// allowlist. The CIDRs are parsed once, when the validator is built; a
+// malformed entry fails every validation naming that entry.
+func IPInAnyCIDR(ip string, cidrs []string) ValidatorFunc {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	bad := ""
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			bad = c
+			break
+		}
+		nets = append(nets, n)
+	}
+	return func() ValidationResult {
+		if bad != "" {
+			return FailCode("net.cidr", "invalid CIDR in list: "+bad, "cidr", bad)
+		}
+		addr := net.ParseIP(ip)
+		if addr == nil {
+			return FailCode("net.ip", "must be IP")
+		}
+		for _, n := range nets {
+			if n.Contains(addr) {
+				return Success()
+			}
+		}
+		return FailCode("net.ip_in_cidr", "IP not in any allowed range")
+	}
+}
+
+// IsPort validates a TCP/UDP port number in 1-65535.
+func IsPort(v int) ValidatorFunc {
+	return func() ValidationResult {
+		if v < 1 || v > 65535 {
+			return FailCode("net.port", "must be a valid port (1-65535)")
 		}
 		return Success()
 	}
 }
 
+// IsPortOrZero is like IsPort but also accepts 0, meaning "any port".
+func IsPortOrZero(v int) ValidatorFunc {
+	return func() ValidationResult {
+		if v < 0 || v > 65535 {
+			return FailCode("net.port", "must be a valid port (0-65535)")
+		}
+		return Success()
+	}
+}
+
+// IsPortString parses s as a decimal port number (ASCII digits only, no
+// sign) and validates it with IsPort.
+func IsPortString(s string) ValidatorFunc {
+	return func() ValidationResult {
+		for i := 0; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return FailCode("net.port", "must be a valid port (1-65535)")
+			}
+		}
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return FailCode("net.port", "must be a valid port (1-65535)")
+		}
+		return IsPort(v)()
+	}
+}
+
+// IsHostPort validates a "host:port" address: the host must be a hostname
+// or IP (IPv6 in brackets, e.g. "[::1]:8080") and the port in 1-65535.
+func IsHostPort(s string) ValidatorFunc {
+	return func() ValidationResult {
+		host, port, err := net.SplitHostPort(s)
+		if err != nil {
+			return FailCode("net.host_port", "must be host:port")
+		}
+		if host == "" {
+			return FailCode("net.host_port_host", "missing host")
+		}
+		ip := net.ParseIP(host)
+		bracketed := strings.HasPrefix(s, "[")
+		if bracketed && (ip == nil || ip.To4() != nil) {
+			return FailCode("net.host_port_host", "invalid host: brackets require an IPv6 address")
+		}
+		if ip == nil && !IsHostname(host)().IsValid {
+			return FailCode("net.host_port_host", "invalid host")
+		}
+		return IsPortString(port)()
+	}
+}
+
 // Email domain policies (simple split)
 func EmailDomainAllowlist(s string, allowed []string) ValidatorFunc {
 	return func() ValidationResult {
 		at := strings.LastIndexByte(s, '@')
 		if at == -1 {
-			return Fail("invalid email")
+			return FailCode("email.invalid", "invalid email")
 		}
 		dom := strings.ToLower(s[at+1:])
 		for _, d := range allowed {
@@ -590,19 +1880,19 @@ func EmailDomainAllowlist(s string, allowed []string) ValidatorFunc {
 				return Success()
 			}
 		}
-		return Fail("email domain not allowed")
+		return FailCode("email.domain_not_allowed", "email domain not allowed", "domain", dom)
 	}
 }
 func EmailDomainBlocklist(s string, blocked []string) ValidatorFunc {
 	return func() ValidationResult {
 		at := strings.LastIndexByte(s, '@')
 		if at == -1 {
-			return Fail("invalid email")
+			return FailCode("email.invalid", "invalid email")
 		}
 		dom := strings.ToLower(s[at+1:])
 		for _, d := range blocked {
 			if dom == strings.ToLower(d) {
-				return Fail("email domain blocked")
+				return FailCode("email.domain_blocked", "email domain blocked", "domain", dom)
 			}
 		}
 		return Success()
@@ -621,7 +1911,7 @@ func LuhnValid(s string) ValidatorFunc {
 				continue
 			}
 			if ch < '0' || ch > '9' {
-				return Fail("must be numeric")
+				return FailCode("string.numeric", "must be numeric")
 			}
 			d := int(ch - '0')
 			if alt {
@@ -635,14 +1925,185 @@ func LuhnValid(s string) ValidatorFunc {
 			digits++
 		}
 		if digits == 0 || sum%10 != 0 {
-			return Fail("invalid luhn")
+			return FailCode("checksum.luhn", "invalid luhn")
+		}
+		return Success()
+	}
+}
+
+// LuhnModN validates the Luhn mod N checksum of s over alphabet, where N is
+// len(alphabet) and each character stands for its byte index in alphabet
+// ("0123456789" is plain Luhn, "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ" mod
+// 36). The last character of s is the check character. Characters are
+// matched exactly, so a case-insensitive alphabet needs s normalized first.
+func LuhnModN(s string, alphabet string) ValidatorFunc {
+	return func() ValidationResult {
+		n := len(alphabet)
+		if n < 2 {
+			return FailCode("checksum.alphabet", "invalid alphabet")
+		}
+		if s == "" {
+			return FailCode("checksum.luhn_mod_n", "invalid checksum")
+		}
+		sum := 0
+		factor := 1
+		for i := len(s) - 1; i >= 0; i-- {
+			cp := strings.IndexByte(alphabet, s[i])
+			if cp < 0 {
+				return FailCode("checksum.alphabet_char", "invalid character "+strconv.Quote(s[i:i+1]), "char", s[i:i+1])
+			}
+			addend := factor * cp
+			sum += addend/n + addend%n
+			factor = 3 - factor
+		}
+		if sum%n != 0 {
+			return FailCode("checksum.luhn_mod_n", "invalid checksum")
+		}
+		return Success()
+	}
+}
+
+// LuhnValidLen is like LuhnValid but also requires exactly length digits,
+// ignoring spaces, e.g. 15 for an IMEI or 16 for most card numbers.
+func LuhnValidLen(s string, length int) ValidatorFunc {
+	return func() ValidationResult {
+		digits := 0
+		for i := 0; i < len(s); i++ {
+			switch {
+			case s[i] == ' ':
+			case s[i] < '0' || s[i] > '9':
+				return FailCode("string.numeric", "must be numeric")
+			default:
+				digits++
+			}
+		}
+		if digits != length {
+			return FailCode("checksum.length", "must be "+strconv.Itoa(length)+" digits", "length", length)
+		}
+		return LuhnValid(s)()
+	}
+}
+
+// IsIMEI validates a 15-digit IMEI whose last digit is the Luhn check digit
+// over the first 14.
+func IsIMEI(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if len(s) != 15 || strings.Trim(s, "0123456789") != "" || !LuhnValid(s)().IsValid {
+			return FailCode("device.imei", "invalid IMEI")
+		}
+		return Success()
+	}
+}
+
+// IsIMEISV validates a 16-digit IMEISV (IMEI with software version), which
+// carries no check digit.
+func IsIMEISV(s string) ValidatorFunc {
+	return func() ValidationResult {
+		if len(s) != 16 || strings.Trim(s, "0123456789") != "" {
+			return FailCode("device.imeisv", "invalid IMEISV")
+		}
+		return Success()
+	}
+}
+
+// IsCardExpiry validates a card expiry date in the form MM/YY or MM/YYYY
+// that has not passed; a card is valid through the end of its expiry month.
+func IsCardExpiry(s string) ValidatorFunc {
+	return IsCardExpiryWith(s, time.Now)
+}
+
+// IsCardExpiryWith is like IsCardExpiry but reads the current time from now.
+func IsCardExpiryWith(s string, now func() time.Time) ValidatorFunc {
+	return func() ValidationResult {
+		mm, yy, ok := strings.Cut(s, "/")
+		if !ok || len(mm) != 2 || (len(yy) != 2 && len(yy) != 4) ||
+			strings.Trim(mm, "0123456789") != "" || strings.Trim(yy, "0123456789") != "" {
+			return FailCode("card.expiry_format", "invalid expiry format")
+		}
+		month, _ := strconv.Atoi(mm)
+		year, _ := strconv.Atoi(yy)
+		if month < 1 || month > 12 {
+			return FailCode("card.expiry_format", "invalid expiry format")
+		}
+		if len(yy) == 2 {
+			year += 2000
+		}
+		t := now()
+		if year < t.Year() || (year == t.Year() && time.Month(month) < t.Month()) {
+			return FailCode("card.expired", "card expired")
+		}
+		return Success()
+	}
+}
+
+// reMaskedCard matches "*" runs, optionally grouped with single spaces or
+// dashes, followed by the last four digits.
+var reMaskedCard = regexp.MustCompile(`^\*+(?:[ -]\*+)*[ -]?[0-9]{4}$`)
+
+// IsMaskedCard validates the display format of a masked card number: the
+// last four digits revealed and every other digit replaced by "*", e.g.
+// "**** **** **** 4242" or "************4242", for 12 to 19 digits in
+// total. It does not check the Luhn digit.
+func IsMaskedCard(s string) ValidatorFunc {
+	return func() ValidationResult {
+		n := len(s) - strings.Count(s, " ") - strings.Count(s, "-")
+		if n < 12 || n > 19 || !reMaskedCard.MatchString(s) {
+			return FailCode("card.masked", "invalid masked card")
 		}
 		return Success()
 	}
 }
 
+// eanCheck validates a GS1 barcode of one of the given lengths: digits
+// weighted 1,3,1,3... from the right (check digit included) must sum to a
+// multiple of 10.
+func eanCheck(s, name string, lengths ...int) ValidationResult {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return FailCode("string.numeric", "must be numeric")
+		}
+	}
+	ok := false
+	for _, n := range lengths {
+		ok = ok || len(s) == n
+	}
+	if !ok {
+		return FailCode("checksum.ean_len", "must be "+name)
+	}
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-1-i)%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	if sum%10 != 0 {
+		return FailCode("checksum.ean", "invalid EAN check digit")
+	}
+	return Success()
+}
+
+// IsEAN8 validates an 8-digit EAN barcode including its GS1 check digit.
+func IsEAN8(s string) ValidatorFunc {
+	return func() ValidationResult { return eanCheck(s, "EAN-8", 8) }
+}
+
+// IsEAN13 validates a 13-digit EAN barcode (GTIN-13) including its check digit.
+func IsEAN13(s string) ValidatorFunc {
+	return func() ValidationResult { return eanCheck(s, "EAN-13", 13) }
+}
+
+// IsEAN accepts either an EAN-8 or an EAN-13 barcode.
+func IsEAN(s string) ValidatorFunc {
+	return func() ValidationResult { return eanCheck(s, "EAN-8 or EAN-13", 8, 13) }
+}
+
 func trimFloatZeros(f float64) string {
 	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		return s
+	}
 	// trim trailing zeros and optional dot
 	i := len(s)
 	for i > 0 && s[i-1] == '0' {
@@ -0,0 +1,195 @@
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsonSchema is the supported subset of JSON Schema.
+type jsonSchema struct {
+	Type       json.RawMessage        `json:"type"` // a type name or a list of them
+	Required   []string               `json:"required"`
+	Properties map[string]*jsonSchema `json:"properties"`
+	Items      *jsonSchema            `json:"items"`
+	Minimum    *float64               `json:"minimum"`
+	Maximum    *float64               `json:"maximum"`
+	MinLength  *int                   `json:"minLength"`
+	MaxLength  *int                   `json:"maxLength"`
+	Pattern    string                 `json:"pattern"`
+	Enum       []json.RawMessage      `json:"enum"`
+}
+
+// MatchesJSONSchema validates the JSON document data against schema, which
+// may use the keywords type, required, properties, items, minimum,
+// maximum, minLength, maxLength, pattern and enum; other keywords are
+// ignored. Every violation becomes a message prefixed with the JSON pointer
+// of the offending value, e.g. "/address/zip: must match pattern"; the
+// pointer is also reported as Failure.Field. Violations at the document
+// root carry no prefix.
+func MatchesJSONSchema(data []byte, schema []byte) ValidationResult {
+	var s jsonSchema
+	if err := json.Unmarshal(schema, &s); err != nil || s.check() != nil {
+		return FailCode("json.invalid_schema", "invalid schema")
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return FailCode("json.invalid", "invalid JSON")
+	}
+	var b ResultBuilder
+	if err := s.validate(&b, "", v); err != nil {
+		return FailCode("json.invalid_schema", "invalid schema")
+	}
+	return b.Result()
+}
+
+// check reports schema errors that do not depend on the data: null
+// sub-schemas and patterns that do not compile.
+func (s *jsonSchema) check() error {
+	if s.Pattern != "" && compilePattern(s.Pattern) == nil {
+		return errors.New("invalid pattern " + strconv.Quote(s.Pattern))
+	}
+	for name, p := range s.Properties {
+		if p == nil {
+			return errors.New("null schema for property " + strconv.Quote(name))
+		}
+		if err := p.check(); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.check()
+	}
+	return nil
+}
+
+// validate checks v at pointer against s, recording violations in b. It
+// returns an error only for a malformed schema.
+func (s *jsonSchema) validate(b *ResultBuilder, pointer string, v any) error {
+	add := func(res ValidationResult) { b.addAt(pointer, res) }
+
+	if len(s.Type) > 0 {
+		types, err := schemaTypes(s.Type)
+		if err != nil {
+			return err
+		}
+		if !matchesAnyType(v, types) {
+			add(FailCode("json.type", "must be of type "+strings.Join(types, " or "), "type", types))
+			// Other keywords would only repeat the mismatch.
+			return nil
+		}
+	}
+
+	if len(s.Enum) > 0 {
+		found := false
+		allowed := make([]string, len(s.Enum))
+		for i, raw := range s.Enum {
+			var e any
+			if err := json.Unmarshal(raw, &e); err != nil {
+				return err
+			}
+			found = found || reflect.DeepEqual(v, e)
+			allowed[i] = string(raw)
+		}
+		if !found {
+			add(FailCode("json.enum", "must be one of: "+strings.Join(allowed, ", "), "allowed", allowed))
+		}
+	}
+
+	switch v := v.(type) {
+	case float64:
+		if s.Minimum != nil {
+			add(FloatMin(v, *s.Minimum)())
+		}
+		if s.Maximum != nil {
+			add(FloatMax(v, *s.Maximum)())
+		}
+	case string:
+		// JSON Schema counts characters, not bytes.
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			add(FailCode("string.min_len", "too short: min "+strconv.Itoa(*s.MinLength), "min", *s.MinLength))
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			add(FailCode("string.max_len", "too long: max "+strconv.Itoa(*s.MaxLength), "max", *s.MaxLength))
+		}
+		if s.Pattern != "" {
+			add(MatchesString(v, s.Pattern)())
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			_, ok := v[name]
+			if !ok {
+				b.addAt(pointer+"/"+escapePointer(name), RequireIf(true, "").Validate())
+			}
+		}
+		names := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if pv, ok := v[name]; ok {
+				if err := s.Properties[name].validate(b, pointer+"/"+escapePointer(name), pv); err != nil {
+					return err
+				}
+			}
+		}
+	case []any:
+		if s.Items != nil {
+			for i, item := range v {
+				if err := s.Items.validate(b, pointer+"/"+strconv.Itoa(i), item); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func schemaTypes(raw json.RawMessage) ([]string, error) {
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil {
+		return []string{one}, nil
+	}
+	var many []string
+	err := json.Unmarshal(raw, &many)
+	return many, err
+}
+
+func matchesAnyType(v any, types []string) bool {
+	for _, t := range types {
+		if matchesType(v, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(v any, t string) bool {
+	switch v := v.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case float64:
+		return t == "number" || (t == "integer" && v == math.Trunc(v))
+	case string:
+		return t == "string"
+	case []any:
+		return t == "array"
+	case map[string]any:
+		return t == "object"
+	}
+	return false
+}
+
+// escapePointer escapes a property name for use in a JSON pointer (RFC 6901).
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
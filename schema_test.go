@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+const personSchema = `{
+	"type": "object",
+	"required": ["name", "age", "address"],
+	"properties": {
+		"name": {"type": "string", "minLength": 2, "maxLength": 20},
+		"age": {"type": "integer", "minimum": 0, "maximum": 150},
+		"role": {"enum": ["admin", "user"]},
+		"tags": {"type": "array", "items": {"type": "string", "pattern": "^[a-z]+$"}},
+		"address": {
+			"type": "object",
+			"required": ["city", "zip"],
+			"properties": {
+				"city": {"type": "string"},
+				"zip": {"type": "string", "pattern": "^[0-9]{5}$"}
+			}
+		}
+	}
+}`
+
+func TestMatchesJSONSchema(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		data      string
+		schema    string
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"valid document", `{"name":"Ann","age":30,"role":"user","tags":["a"],"address":{"city":"Oslo","zip":"01234"}}`, personSchema, true, nil},
+		{"nested violations", `{"name":"A","age":200.5,"role":"root","tags":["ok","Bad"],"address":{"zip":"12"}}`, personSchema, false, []string{
+			"/address/city: is required",
+			"/address/zip: must match pattern",
+			"/age: must be of type integer",
+			"/name: too short: min 2",
+			"/role: must be one of: \"admin\", \"user\"",
+			"/tags/1: must match pattern",
+		}},
+		{"missing required", `{"address":{"city":"Oslo","zip":"01234"}}`, personSchema, false, []string{"/name: is required", "/age: is required"}},
+		{"root type", `[]`, personSchema, false, []string{"must be of type object"}},
+		{"multiple types", `null`, `{"type":["string","null"]}`, true, nil},
+		{"minimum", `-1`, `{"type":"number","minimum":0}`, false, []string{"must be >= 0"}},
+		{"maxLength counts runes", `"héllo"`, `{"maxLength":5}`, true, nil},
+		{"escaped pointer", `{"a/b":1}`, `{"properties":{"a/b":{"type":"string"}}}`, false, []string{"/a~1b: must be of type string"}},
+		{"invalid data", `{`, personSchema, false, []string{"invalid JSON"}},
+		{"invalid schema", `{}`, `{"type":1}`, false, []string{"invalid schema"}},
+		{"null property schema", `{"a":1}`, `{"properties":{"a":null}}`, false, []string{"invalid schema"}},
+		{"null property schema unused", `{}`, `{"properties":{"a":null}}`, false, []string{"invalid schema"}},
+		{"bad pattern", `"x"`, `{"pattern":"("}`, false, []string{"invalid schema"}},
+		{"bad nested pattern", `[]`, `{"items":{"pattern":"["}}`, false, []string{"invalid schema"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := MatchesJSONSchema([]byte(tc.data), []byte(tc.schema))
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestMatchesJSONSchemaFields(t *testing.T) {
+	t.Parallel()
+	res := MatchesJSONSchema([]byte(`{"name":"A","age":1,"address":{"city":"x","zip":"1"}}`), []byte(personSchema))
+	var fields []string
+	for _, f := range res.Failures() {
+		fields = append(fields, f.Field)
+	}
+	want := []string{"/address/zip", "/name"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("fields=%v want %v", fields, want)
+	}
+}
@@ -0,0 +1,330 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidateStruct validates v, a struct or a pointer to one, using the rules
+// listed in its `validate` field tags. Rules are names registered with
+// RegisterRule, separated by commas; arguments follow "=" separated by
+// spaces, and the field value is passed as the first argument. An argument
+// wrapped in single quotes may contain commas and spaces; a doubled quote
+// inside it stands for one quote:
+//
+//	type Address struct {
+//		PostalCode string `json:"postal_code" validate:"non_empty,matches=^[0-9]{5}$"`
+//		Unit       string `json:"unit" validate:"matches='^[0-9]{1,3}$'"`
+//	}
+//
+// The rules of a field run in order and stop at its first failure. Nested
+// structs, pointers and slices are walked; rules on a slice apply to each
+// element. Messages are prefixed with the field path built from json names
+// (or Go names), e.g. "address.postal_code: must match pattern" and
+// "items[2].sku: must not be empty", and the path is reported as
+// Failure.Field. Fields of embedded structs are treated as fields of the
+// outer struct. Nil pointers, unexported fields and fields tagged json:"-"
+// are skipped.
+//
+// Nesting deeper than DefaultMaxStructDepth, and pointers leading back to a
+// value that is already being validated, fail with "validation depth
+// exceeded" at that path instead of recursing further.
+func ValidateStruct(v any) ValidationResult {
+	return ValidateStructDepth(v, DefaultMaxStructDepth)
+}
+
+// DefaultMaxStructDepth is the deepest nesting of structs and slices
+// ValidateStruct walks.
+const DefaultMaxStructDepth = 32
+
+// ValidateStructDepth is like ValidateStruct but walks at most maxDepth
+// levels of nested structs and slices; the root struct is level 1.
+func ValidateStructDepth(v any, maxDepth int) ValidationResult {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return FailCode("struct.type", "must be a struct")
+	}
+	w := structWalker{maxDepth: maxDepth}
+	w.value("", reflect.ValueOf(v), "")
+	return w.b.Result()
+}
+
+// structWalker holds the state of one ValidateStruct run.
+type structWalker struct {
+	b        ResultBuilder
+	maxDepth int
+	depth    int
+	// visiting holds the pointers on the path from the root to the value
+	// being validated, to detect cycles.
+	visiting map[visitKey]struct{}
+}
+
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// enter descends one level into the value at path, or records a depth
+// failure and returns false when the limit is reached.
+func (w *structWalker) enter(path string) bool {
+	if w.depth >= w.maxDepth {
+		w.b.addAt(path, FailCode("struct.depth", "validation depth exceeded", "max", w.maxDepth))
+		return false
+	}
+	w.depth++
+	return true
+}
+
+// FieldError is one violation found by ValidateStructErrors. Message is the
+// rule's message without the field path, which is in Field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error renders the error as "field: message".
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ValidateStructErrors is like ValidateStruct but returns one FieldError
+// per violation, in field order, or nil when v is valid.
+func ValidateStructErrors(v any) []FieldError {
+	res := ValidateStruct(v)
+	if res.IsValid {
+		return nil
+	}
+	out := make([]FieldError, len(res.Message))
+	for i, m := range res.Message {
+		fl := res.failureAt(i)
+		out[i] = FieldError{Field: fl.field, Code: fl.code, Message: strings.TrimPrefix(m, fl.field+": ")}
+	}
+	return out
+}
+
+func (w *structWalker) fields(path string, rv reflect.Value) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, ok := structFieldName(sf)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		if sf.Anonymous && name == "" {
+			if fv.Kind() == reflect.Pointer {
+				// Walk it as a value at path so cycles through embedded
+				// pointers are caught; its fields keep the outer path.
+				w.value(path, fv, "")
+			} else if fv.Kind() == reflect.Struct {
+				w.fields(path, fv)
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+		w.value(name, fv, sf.Tag.Get("validate"))
+	}
+}
+
+// structFieldName returns the json name of a field, "" when it has none,
+// and false when the field is excluded with json:"-".
+func structFieldName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name, true
+}
+
+func (w *structWalker) value(path string, v reflect.Value, rules string) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Pointer {
+			key := visitKey{v.Pointer(), v.Type()}
+			if _, ok := w.visiting[key]; ok {
+				w.b.addAt(path, FailCode("struct.depth", "validation depth exceeded", "max", w.maxDepth))
+				return
+			}
+			if w.visiting == nil {
+				w.visiting = make(map[visitKey]struct{})
+			}
+			w.visiting[key] = struct{}{}
+			defer delete(w.visiting, key)
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if w.enter(path) {
+			w.fields(path, v)
+			w.depth--
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		if rules == "" && !mayHoldStruct(v.Type().Elem()) {
+			return
+		}
+		if w.enter(path) {
+			for i := 0; i < v.Len(); i++ {
+				w.value(path+"["+strconv.Itoa(i)+"]", v.Index(i), rules)
+			}
+			w.depth--
+		}
+		return
+	}
+	if rules == "" {
+		return
+	}
+	s, ok := scalarString(v)
+	if !ok {
+		w.b.addAt(path, FailCode("struct.unsupported", "unsupported field type "+v.Type().String()))
+		return
+	}
+	calls, err := parseValidateTag(rules)
+	if err != nil {
+		w.b.addAt(path, FailCode("struct.tag", "invalid validate tag: "+err.Error()))
+		return
+	}
+	for _, c := range calls {
+		r, err := buildRule(c.name, append([]string{s}, c.args...))
+		if err != nil {
+			w.b.addAt(path, FailCode("struct.tag", "invalid validate tag: "+err.Error()))
+			return
+		}
+		if res := r.Validate(); !res.IsValid {
+			w.b.addAt(path, res)
+			return
+		}
+	}
+}
+
+// tagRule is one rule of a validate tag.
+type tagRule struct {
+	name string
+	args []string
+}
+
+// parseValidateTag splits a validate tag into rules at commas and their
+// arguments at spaces. An argument starting with a single quote runs to the
+// closing quote and may contain both; a doubled quote inside it is a
+// literal quote.
+func parseValidateTag(tag string) ([]tagRule, error) {
+	var rules []tagRule
+	var cur tagRule
+	var arg strings.Builder
+	inArgs, hasArg := false, false
+	flush := func() {
+		if hasArg {
+			cur.args = append(cur.args, arg.String())
+		}
+		arg.Reset()
+		hasArg = false
+	}
+	for i := 0; i < len(tag); i++ {
+		switch c := tag[i]; {
+		case c == ',':
+			if inArgs {
+				flush()
+			} else {
+				cur.name = strings.TrimSpace(arg.String())
+				arg.Reset()
+			}
+			rules = append(rules, cur)
+			cur, inArgs, hasArg = tagRule{}, false, false
+		case !inArgs && c == '=':
+			cur.name = strings.TrimSpace(arg.String())
+			arg.Reset()
+			inArgs, hasArg = true, false
+		case inArgs && (c == ' ' || c == '\t'):
+			flush()
+		case inArgs && c == '\'' && !hasArg:
+			for {
+				end := strings.IndexByte(tag[i+1:], '\'')
+				if end == -1 {
+					return nil, errors.New("unterminated quote in rule " + strconv.Quote(cur.name))
+				}
+				arg.WriteString(tag[i+1 : i+1+end])
+				i += end + 1
+				if i+1 < len(tag) && tag[i+1] == '\'' {
+					arg.WriteByte('\'')
+					i++
+					continue
+				}
+				break
+			}
+			hasArg = true
+			if i+1 < len(tag) && tag[i+1] != ',' && tag[i+1] != ' ' && tag[i+1] != '\t' {
+				return nil, errors.New("unexpected text after quoted argument in rule " + strconv.Quote(cur.name))
+			}
+		default:
+			arg.WriteByte(c)
+			hasArg = true
+		}
+	}
+	if inArgs {
+		flush()
+	} else {
+		cur.name = strings.TrimSpace(arg.String())
+	}
+	return append(rules, cur), nil
+}
+
+// scalarString formats a basic value as the string argument rules take.
+func scalarString(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	}
+	return "", false
+}
+
+// mayHoldStruct reports whether values of type t can contain struct fields
+// to walk. Element types are followed until one repeats, so recursive types
+// such as `type T []T` terminate.
+func mayHoldStruct(t reflect.Type) bool {
+	var seen []reflect.Type
+	for {
+		switch t.Kind() {
+		case reflect.Struct, reflect.Interface:
+			return true
+		case reflect.Pointer, reflect.Slice, reflect.Array:
+			for _, s := range seen {
+				if s == t {
+					return false
+				}
+			}
+			seen = append(seen, t)
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+type svAddress struct {
+	Street     string `json:"street" validate:"non_empty"`
+	PostalCode string `json:"postal_code" validate:"non_empty,matches=^[0-9]{5}$"`
+	Geo        *svGeo `json:"geo"`
+}
+
+type svGeo struct {
+	Lat     float64 `json:"lat"`
+	Country string  `json:"country" validate:"country_code_iso2"`
+}
+
+type svItem struct {
+	SKU string `json:"sku" validate:"non_empty"`
+	Qty int    `json:"qty" validate:"int_between=1 100"`
+}
+
+type svMeta struct {
+	Source string `json:"source" validate:"one_of=web app"`
+}
+
+type svOrder struct {
+	svMeta
+	Email    string     `json:"email" validate:"email"`
+	Address  svAddress  `json:"address"`
+	Billing  *svAddress `json:"billing"`
+	Items    []svItem   `json:"items"`
+	Tags     []string   `json:"tags" validate:"min_len=2"`
+	Note     string     // untagged: not validated
+	Internal string     `json:"-" validate:"non_empty"`
+	secret   string
+}
+
+func TestValidateStruct(t *testing.T) {
+	t.Parallel()
+	valid := svOrder{
+		svMeta:  svMeta{Source: "web"},
+		Email:   "a@example.com",
+		Address: svAddress{Street: "Main St", PostalCode: "12345", Geo: &svGeo{Country: "DE"}},
+		Items:   []svItem{{"A-1", 1}},
+		Tags:    []string{"vip"},
+	}
+	tests := []struct {
+		name       string
+		v          any
+		wantValid  bool
+		wantMsg    []string
+		wantFields []string
+	}{
+		{"valid", valid, true, nil, nil},
+		{"pointer", &valid, true, nil, nil},
+		{"nil pointer", (*svOrder)(nil), true, nil, nil},
+		{"not a struct", 42, false, []string{"must be a struct"}, []string{""}},
+		{"nil", nil, false, []string{"must be a struct"}, []string{""}},
+		{"nested and slices", svOrder{
+			svMeta:  svMeta{Source: "fax"},
+			Email:   "a@example.com",
+			Address: svAddress{Street: "Main St", PostalCode: "1234", Geo: &svGeo{Country: "XX"}},
+			Billing: &svAddress{PostalCode: "54321"},
+			Items:   []svItem{{"A-1", 1}, {"A-2", 0}, {"", 5}},
+			Tags:    []string{"vip", "x"},
+		}, false, []string{
+			"source: must be one of: web, app",
+			"address.postal_code: must match pattern",
+			"address.geo.country: must be an ISO 3166-1 alpha-2 country code",
+			"billing.street: must not be empty",
+			"items[1].qty: must be between 1 and 100",
+			"items[2].sku: must not be empty",
+			"tags[1]: too short: min 2",
+		}, []string{"source", "address.postal_code", "address.geo.country", "billing.street", "items[1].qty", "items[2].sku", "tags[1]"}},
+		{"first failing rule per field", svAddress{Street: "x"}, false, []string{"postal_code: must not be empty"}, []string{"postal_code"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ValidateStruct(tc.v)
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+			var fields []string
+			for _, f := range res.Failures() {
+				fields = append(fields, f.Field)
+			}
+			if !reflect.DeepEqual(fields, tc.wantFields) {
+				t.Fatalf("fields=%q want %q", fields, tc.wantFields)
+			}
+		})
+	}
+}
+
+func TestValidateStructBadTag(t *testing.T) {
+	t.Parallel()
+	type bad struct {
+		Name string `validate:"no_such_rule"`
+		Age  int    `validate:"int_min=x"`
+	}
+	res := ValidateStruct(bad{})
+	want := []string{
+		`Name: invalid validate tag: unknown rule "no_such_rule"`,
+		`Age: invalid validate tag: rule "int_min": invalid int arg "x"`,
+	}
+	if res.IsValid || !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("msg=%v want %v", res.Message, want)
+	}
+}
+
+func TestValidateStructLocalize(t *testing.T) {
+	t.Parallel()
+	res := ValidateStruct(svItem{SKU: "A", Qty: 0})
+	got := Localize(res, Catalog{"number.between": func(args ...any) string { return "hors limites" }})
+	if want := []string{"qty: hors limites"}; !reflect.DeepEqual(got.Message, want) {
+		t.Fatalf("msg=%v want %v", got.Message, want)
+	}
+	nested := ValidateStruct(svOrder{svMeta: svMeta{"web"}, Email: "a@b.co", Address: svAddress{Street: "s", PostalCode: "1"}})
+	got = Localize(nested, Catalog{"string.matches": func(args ...any) string { return "format invalide" }})
+	if want := []string{"address.postal_code: format invalide"}; !reflect.DeepEqual(got.Message, want) {
+		t.Fatalf("msg=%v want %v", got.Message, want)
+	}
+}
+
+func TestValidateStructErrors(t *testing.T) {
+	t.Parallel()
+	type signup struct {
+		Email string   `json:"email" validate:"email"`
+		Age   int      `json:"age" validate:"int_min=18"`
+		Name  string   `json:"name" validate:"non_empty,max_len=5"`
+		Items []svItem `json:"items"`
+	}
+	got := ValidateStructErrors(signup{Email: "nope", Age: 16, Name: "Bob", Items: []svItem{{"", 1}}})
+	want := []FieldError{
+		{Field: "email", Code: "email.invalid", Message: "invalid email"},
+		{Field: "age", Code: "number.min", Message: "must be >= 18"},
+		{Field: "items[0].sku", Code: "string.non_empty", Message: "must not be empty"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("errors=%+v want %+v", got, want)
+	}
+	if s := got[2].Error(); s != "items[0].sku: must not be empty" {
+		t.Fatalf("Error()=%q", s)
+	}
+	if s := (FieldError{Code: "struct.type", Message: "must be a struct"}).Error(); s != "must be a struct" {
+		t.Fatalf("Error() without field=%q", s)
+	}
+	if got := ValidateStructErrors(signup{Email: "a@b.co", Age: 18, Name: "Ann"}); got != nil {
+		t.Fatalf("valid struct: errors=%+v want nil", got)
+	}
+}
+
+type svNode struct {
+	Name string  `json:"name" validate:"non_empty"`
+	Next *svNode `json:"next"`
+}
+
+func TestValidateStructCycles(t *testing.T) {
+	t.Parallel()
+	self := &svNode{Name: "a"}
+	self.Next = self
+	res := ValidateStruct(self)
+	if want := []string{"next: validation depth exceeded"}; res.IsValid || !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("self reference: msg=%v want %v", res.Message, want)
+	}
+
+	a, b := &svNode{Name: "a"}, &svNode{}
+	a.Next, b.Next = b, a
+	res = ValidateStruct(a)
+	want := []string{"next.name: must not be empty", "next.next: validation depth exceeded"}
+	if res.IsValid || !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("two-node cycle: msg=%v want %v", res.Message, want)
+	}
+
+	// The same pointer reached along different paths is not a cycle.
+	shared := &svGeo{Country: "DE"}
+	ok := svOrder{svMeta: svMeta{"web"}, Email: "a@b.co",
+		Address: svAddress{Street: "s", PostalCode: "12345", Geo: shared},
+		Billing: &svAddress{Street: "s", PostalCode: "12345", Geo: shared}}
+	if res := ValidateStruct(ok); !res.IsValid {
+		t.Fatalf("shared pointer: msg=%v want valid", res.Message)
+	}
+}
+
+type (
+	svRecSlice []svRecSlice
+	svRecPtr   *svRecPtr
+)
+
+func TestValidateStructRecursiveTypes(t *testing.T) {
+	t.Parallel()
+	type holder struct {
+		Slice svRecSlice  `json:"slice"`
+		Array [1]svRecPtr `json:"array"`
+	}
+	v := holder{Slice: svRecSlice{{}, {{}}}}
+	if res := ValidateStruct(v); !res.IsValid {
+		t.Fatalf("msg=%v want valid", res.Message)
+	}
+}
+
+func TestValidateStructMaxDepth(t *testing.T) {
+	t.Parallel()
+	list := &svNode{Name: "0"}
+	for n := list; ; n = n.Next {
+		if len(n.Name) == 3 {
+			break
+		}
+		n.Next = &svNode{Name: n.Name + "0"}
+	}
+	// Depth 1 is the root struct, so a limit of 2 stops at its child's child.
+	res := ValidateStructDepth(list, 2)
+	if want := []string{"next.next: validation depth exceeded"}; res.IsValid || !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("msg=%v want %v", res.Message, want)
+	}
+	if res.Failures()[0].Code != "struct.depth" {
+		t.Fatalf("code=%q want struct.depth", res.Failures()[0].Code)
+	}
+	if res := ValidateStruct(list); !res.IsValid {
+		t.Fatalf("default depth: msg=%v want valid", res.Message)
+	}
+}
+
+func TestParseValidateTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		tag     string
+		want    []tagRule
+		wantErr string
+	}{
+		{"plain", "non_empty,int_between=1 100", []tagRule{{"non_empty", nil}, {"int_between", []string{"1", "100"}}}, ""},
+		{"spaces around rules", " non_empty , max_len=5", []tagRule{{"non_empty", nil}, {"max_len", []string{"5"}}}, ""},
+		{"quoted comma", "matches='^[0-9]{1,3}$',non_empty", []tagRule{{"matches", []string{"^[0-9]{1,3}$"}}, {"non_empty", nil}}, ""},
+		{"quoted space", "one_of='New York' Boston", []tagRule{{"one_of", []string{"New York", "Boston"}}}, ""},
+		{"doubled quote", "one_of='it''s' ''", []tagRule{{"one_of", []string{"it's", ""}}}, ""},
+		{"quote inside bare arg", "one_of=it's", []tagRule{{"one_of", []string{"it's"}}}, ""},
+		{"unterminated", "matches='^a,b", nil, `unterminated quote in rule "matches"`},
+		{"text after quote", "one_of='a'b", nil, `unexpected text after quoted argument in rule "one_of"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseValidateTag(tc.tag)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("err=%v want %s", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err=%v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("rules=%+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateStructQuotedArgs(t *testing.T) {
+	t.Parallel()
+	type unit struct {
+		Floor string `json:"floor" validate:"matches='^[0-9]{1,3}$'"`
+		City  string `json:"city" validate:"one_of='New York' Boston"`
+	}
+	tests := []struct {
+		name      string
+		v         unit
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"valid", unit{"12", "New York"}, true, nil},
+		{"pattern with comma", unit{"1234", "Boston"}, false, []string{"floor: must match pattern"}},
+		{"quoted option", unit{"1", "York"}, false, []string{"city: must be one of: New York, Boston"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ValidateStruct(tc.v)
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package validate
+
+import "strings"
+
+// Translator renders a failure message from its stable code. args are the
+// key/value pairs the rule attached to the failure (e.g. "min", 3).
+// Returning "" keeps the default English message.
+type Translator interface {
+	Translate(code string, args ...any) string
+}
+
+// TranslatorFunc is an adapter to allow the use of ordinary functions as translators.
+type TranslatorFunc func(code string, args ...any) string
+
+// Translate calls the underlying function.
+func (f TranslatorFunc) Translate(code string, args ...any) string { return f(code, args...) }
+
+// Catalog is a Translator backed by a map from message code to a
+// rendering function. Codes missing from the map fall back to English.
+type Catalog map[string]func(args ...any) string
+
+// Translate renders code using the catalog entry, if any.
+func (c Catalog) Translate(code string, args ...any) string {
+	if fn, ok := c[code]; ok {
+		return fn(args...)
+	}
+	return ""
+}
+
+// Localize returns a copy of r with every coded failure message rendered by
+// t. Messages without a code, or that t does not translate, are kept as is.
+func Localize(r ValidationResult, t Translator) ValidationResult {
+	if r.IsValid || t == nil || len(r.failures) == 0 {
+		return r
+	}
+	msgs := make([]string, len(r.Message))
+	copy(msgs, r.Message)
+	for i := range msgs {
+		fl := r.failureAt(i)
+		if fl.code == "" {
+			continue
+		}
+		if s := t.Translate(fl.code, fl.args...); s != "" {
+			msgs[i] = fieldPrefix(fl.field, r.Message[i]) + s
+		}
+	}
+	r.Message = msgs
+	return r
+}
+
+// fieldPrefix renders the message prefix for a field path as found on the
+// original message msg: either the whole path, as ValidateStruct writes it
+// ("address.city: "), or one segment at a time, as nested Named validators
+// do ("address: city: ").
+func fieldPrefix(field, msg string) string {
+	if field == "" {
+		return ""
+	}
+	if strings.HasPrefix(msg, field+": ") {
+		return field + ": "
+	}
+	return strings.ReplaceAll(field, ".", ": ") + ": "
+}
+
+// Arg returns the value stored under key in a key/value argument list as
+// passed to Translator.Translate, or nil when absent.
+func Arg(args []any, key string) any {
+	for i := 0; i+1 < len(args); i += 2 {
+		if k, ok := args[i].(string); ok && k == key {
+			return args[i+1]
+		}
+	}
+	return nil
+}
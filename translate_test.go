@@ -0,0 +1,50 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLocalize(t *testing.T) {
+	t.Parallel()
+	es := Catalog{
+		"string.non_empty": func(args ...any) string { return "no debe estar vacío" },
+		"string.min_len": func(args ...any) string {
+			return fmt.Sprintf("demasiado corto: mínimo %v", Arg(args, "min"))
+		},
+		"chain.exactly_one": func(args ...any) string { return "exactamente una debe cumplirse" },
+	}
+	tests := []struct {
+		name    string
+		v       Validator
+		wantMsg []string
+	}{
+		{"translated", New().WithTranslator(es).And(NonEmpty("")), []string{"no debe estar vacío"}},
+		{"args", New().WithTranslator(es).Or(MinLen("a", 3)).Or(MaxLen("abcd", 2)), []string{"demasiado corto: mínimo 3", "too long: max 2"}},
+		{"uncoded kept", New().WithTranslator(es).Or(ValidatorFunc(func() ValidationResult { return Fail("custom") })).Or(NonEmpty("")), []string{"custom", "no debe estar vacío"}},
+		{"nested chain codes", New().WithTranslator(es).And(New().And(NonEmpty(""))), []string{"no debe estar vacío"}},
+		{"xor summary", New().WithTranslator(es).And(NonEmpty("a")).Xor(NonEmpty("b")), []string{"exactamente una debe cumplirse"}},
+		{"no translator", New().And(NonEmpty("")), []string{"must not be empty"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid {
+				t.Fatalf("expected invalid")
+			}
+			if !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+
+	upper := TranslatorFunc(func(code string, args ...any) string { return code })
+	res := Localize(IntMin(1, 2).Validate(), upper)
+	if !reflect.DeepEqual(res.Message, []string{"number.min"}) {
+		t.Fatalf("msg=%v", res.Message)
+	}
+	if orig := IntMin(1, 2).Validate(); orig.Message[0] != "must be >= 2" {
+		t.Fatalf("Localize must not mutate the input, got %v", orig.Message)
+	}
+}
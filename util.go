@@ -1,4 +1,4 @@
-package fluent_validator
+package validate
 
 import (
 	"encoding/json"
@@ -25,6 +25,44 @@ func NewJSONError(errs []string) error {
 	return errors.New(string(b))
 }
 
+// FieldErrorResponse is the field-keyed counterpart of ErrorResponse.
+type FieldErrorResponse struct {
+	Errors map[string][]string `json:"errors"`
+}
+
+// NewFieldJSONError takes failure messages keyed by field and generates a
+// json string error output such as {"errors":{"email":["invalid email"]}}.
+// It returns nil when errs is empty.
+func NewFieldJSONError(errs map[string][]string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(FieldErrorResponse{Errors: errs})
+	if err != nil {
+		return err
+	}
+
+	return errors.New(string(b))
+}
+
+// FieldMessages buckets the messages of per-field results by field name,
+// leaving out fields whose result is valid. It returns nil when every field
+// passed, so its output can be passed straight to NewFieldJSONError.
+func FieldMessages(results map[string]ValidationResult) map[string][]string {
+	var out map[string][]string
+	for field, res := range results {
+		if res.IsValid {
+			continue
+		}
+		if out == nil {
+			out = make(map[string][]string)
+		}
+		out[field] = res.Message
+	}
+	return out
+}
+
 // NewJSONError takes a list of strings and generates a concatenated readable string error output
 func NewErrorFromStrings(errs []string) error {
 	if len(errs) == 0 {
@@ -38,3 +76,26 @@ func NewErrorFromStrings(errs []string) error {
 
 	return errors.Join(eList...)
 }
+
+// ValidationError is the error returned for a failed validation. It keeps
+// the failure messages and unwraps into one error per message, so callers
+// can use errors.As to recover them.
+type ValidationError struct {
+	Messages []string
+}
+
+// Error joins the failure messages with newlines.
+func (e *ValidationError) Error() string {
+	if err := NewErrorFromStrings(e.Messages); err != nil {
+		return err.Error()
+	}
+	return "validation failed"
+}
+
+// Unwrap returns one error per failure message.
+func (e *ValidationError) Unwrap() []error {
+	if j, ok := NewErrorFromStrings(e.Messages).(interface{ Unwrap() []error }); ok {
+		return j.Unwrap()
+	}
+	return nil
+}
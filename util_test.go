@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestErr(t *testing.T) {
+	t.Parallel()
+
+	if err := New().And(NonEmpty("x")).Err(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	err := New().Or(NonEmpty("")).Or(MinLen("a", 2)).Err()
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if got, want := err.Error(), "must not be empty\ntoo short: min 2"; got != want {
+		t.Fatalf("Error()=%q want %q", got, want)
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if want := []string{"must not be empty", "too short: min 2"}; !reflect.DeepEqual(verr.Messages, want) {
+		t.Fatalf("Messages=%v want %v", verr.Messages, want)
+	}
+	if n := len(verr.Unwrap()); n != 2 {
+		t.Fatalf("Unwrap returned %d errors, want 2", n)
+	}
+
+	if err := Success().Err(); err != nil {
+		t.Fatalf("expected nil error for Success, got %v", err)
+	}
+	if err := (&ValidationError{}).Error(); err != "validation failed" {
+		t.Fatalf("empty ValidationError message=%q", err)
+	}
+}
+
+func TestFieldJSONError(t *testing.T) {
+	t.Parallel()
+
+	if err := NewFieldJSONError(nil); err != nil {
+		t.Fatalf("nil map: expected nil error, got %v", err)
+	}
+	if err := NewFieldJSONError(map[string][]string{}); err != nil {
+		t.Fatalf("empty map: expected nil error, got %v", err)
+	}
+
+	results := map[string]ValidationResult{
+		"email": EmailValid("nope")(),
+		"age":   IntMin(12, 18)(),
+		"name":  NonEmpty("Ada")(),
+	}
+	fields := FieldMessages(results)
+	if want := map[string][]string{"email": {"invalid email"}, "age": {"must be >= 18"}}; !reflect.DeepEqual(fields, want) {
+		t.Fatalf("FieldMessages=%v want %v", fields, want)
+	}
+	err := NewFieldJSONError(fields)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if got, want := err.Error(), `{"errors":{"age":["must be \u003e= 18"],"email":["invalid email"]}}`; got != want {
+		t.Fatalf("Error()=%s want %s", got, want)
+	}
+
+	if got := FieldMessages(map[string]ValidationResult{"name": Success()}); got != nil {
+		t.Fatalf("all valid: FieldMessages=%v want nil", got)
+	}
+}
@@ -4,10 +4,47 @@
 // while preserving an explanatory failure message when invalid.
 package validate
 
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
 // ValidationResult represents the outcome of a validation step.
 type ValidationResult struct {
 	IsValid bool
 	Message []string
+
+	// Diagnostics holds the messages of OR members that failed before a
+	// later member passed, when the chain was built with KeepOrFailures.
+	// They do not affect IsValid.
+	Diagnostics []string
+
+	// failures holds the message code and arguments for each entry in
+	// Message, when known. It is nil for results built without codes.
+	failures []failure
+}
+
+// failure records how a single failure message was produced so that it can
+// be re-rendered by a Translator.
+type failure struct {
+	code string
+	args []any
+	// field is the dotted path of Named wrappers around the failing rule.
+	field string
+}
+
+// failureAt returns the code and arguments behind Message[i], or a zero
+// failure when the message was not produced with a code.
+func (r ValidationResult) failureAt(i int) failure {
+	if i < len(r.failures) {
+		return r.failures[i]
+	}
+	return failure{}
 }
 
 // Validator is the contract for any validation step.
@@ -23,24 +60,170 @@ type ValidatorFunc func() ValidationResult
 // Validate calls the underlying function.
 func (f ValidatorFunc) Validate() ValidationResult { return f() }
 
+// ContextValidator is a validation step that needs a context, typically
+// because it performs I/O and must honor cancellation and deadlines.
+type ContextValidator interface {
+	ValidateContext(ctx context.Context) ValidationResult
+}
+
+// ContextValidatorFunc is an adapter to allow the use of ordinary functions as context validators.
+type ContextValidatorFunc func(ctx context.Context) ValidationResult
+
+// ValidateContext calls the underlying function.
+func (f ContextValidatorFunc) ValidateContext(ctx context.Context) ValidationResult { return f(ctx) }
+
+// WithContext binds ctx to cv so it can be used as a regular Validator,
+// e.g. as a step of a FluentValidator.
+func WithContext(ctx context.Context, cv ContextValidator) Validator {
+	return ValidatorFunc(func() ValidationResult { return cv.ValidateContext(ctx) })
+}
+
 // Success returns a successful ValidationResult with an empty message slice.
-func Success() ValidationResult { return ValidationResult{IsValid: true, Message: []string{}} }
+func Success() ValidationResult { return ValidationResult{IsValid: true, Message: emptyMessages} }
+
+// emptyMessages is shared by every successful result to avoid allocating.
+// It has zero capacity, so appending to it always copies.
+var emptyMessages = []string{}
 
-// Fail returns a failed ValidationResult with the provided messages.
-func Fail(msg ...string) ValidationResult { return ValidationResult{IsValid: false, Message: msg} }
+// Fail returns a failed ValidationResult with the provided messages. With
+// no messages, Message is empty but non-nil, so the result is not IsZero.
+func Fail(msg ...string) ValidationResult {
+	if msg == nil {
+		msg = emptyMessages
+	}
+	return ValidationResult{IsValid: false, Message: msg}
+}
+
+// FailCode returns a failed ValidationResult with a single message
+// identified by a stable code such as "string.min_len". msg is the English
+// rendering used when no Translator overrides it, and args are key/value
+// pairs describing the parameters of the failure (e.g. "min", 3).
+func FailCode(code, msg string, args ...any) ValidationResult {
+	return ValidationResult{IsValid: false, Message: []string{msg}, failures: []failure{{code: code, args: args}}}
+}
+
+// Failure is the structured form of a single failure message: a
+// machine-readable code, the rendered message and the named parameters of
+// the check (e.g. {"min": 3}). Code is empty for messages created with Fail.
+// Field is the dotted name of the Named wrappers the failure came through.
+type Failure struct {
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Field   string         `json:"field,omitempty"`
+	Params  map[string]any `json:"params,omitempty"`
+}
+
+// Failures returns one Failure per entry in Message, in the same order.
+// It returns nil for a valid result.
+func (r ValidationResult) Failures() []Failure {
+	if r.IsValid || len(r.Message) == 0 {
+		return nil
+	}
+	out := make([]Failure, len(r.Message))
+	for i, m := range r.Message {
+		fl := r.failureAt(i)
+		out[i] = Failure{Code: fl.code, Message: m, Field: fl.field, Params: fl.params()}
+	}
+	return out
+}
+
+// HasCode reports whether any failure of r carries the given code.
+func (r ValidationResult) HasCode(code string) bool {
+	for i := range r.Message {
+		if r.failureAt(i).code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// params converts the key/value args of a failure into a map.
+func (f failure) params() map[string]any {
+	if len(f.args) < 2 {
+		return nil
+	}
+	m := make(map[string]any, len(f.args)/2)
+	for i := 0; i+1 < len(f.args); i += 2 {
+		if k, ok := f.args[i].(string); ok {
+			m[k] = f.args[i+1]
+		}
+	}
+	return m
+}
 
-// FluentValidator composes multiple validators using AND/OR operators.
+// Error joins the failure messages with newlines. It makes a failed
+// ValidationResult usable as an error; for a valid result it returns "".
+func (r ValidationResult) Error() string {
+	if r.IsValid {
+		return ""
+	}
+	return strings.Join(r.Message, "\n")
+}
+
+// IsZero reports whether r is the zero ValidationResult, i.e. it was never
+// produced by a validator. A zero result is neither valid nor carries any
+// failure message, which distinguishes it from Fail().
+func (r ValidationResult) IsZero() bool {
+	return !r.IsValid && r.Message == nil
+}
+
+type resultJSON struct {
+	Valid       bool     `json:"valid"`
+	Errors      []string `json:"errors"`
+	Diagnostics []string `json:"diagnostics,omitempty"`
+}
+
+// MarshalJSON encodes the result as {"valid":bool,"errors":[...]}, plus
+// "diagnostics" when there are any.
+func (r ValidationResult) MarshalJSON() ([]byte, error) {
+	errs := r.Message
+	if errs == nil {
+		errs = []string{}
+	}
+	return json.Marshal(resultJSON{Valid: r.IsValid, Errors: errs, Diagnostics: r.Diagnostics})
+}
+
+// UnmarshalJSON decodes the form produced by MarshalJSON.
+func (r *ValidationResult) UnmarshalJSON(b []byte) error {
+	var v resultJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.IsValid = v.Valid
+	r.Message = v.Errors
+	r.Diagnostics = v.Diagnostics
+	return nil
+}
+
+// Err returns nil when the result is valid and a *ValidationError
+// carrying the failure messages otherwise.
+func (r ValidationResult) Err() error {
+	if r.IsValid {
+		return nil
+	}
+	return &ValidationError{Messages: r.Message}
+}
+
+// FluentValidator composes multiple validators using AND/OR/XOR operators.
 // By default, evaluation is left-to-right; AND requires all to pass,
-// OR requires at least one to pass. Evaluation short-circuits within
-// contiguous AND/OR segments where possible to avoid wasted work:
+// OR requires at least one to pass, and XOR requires exactly one step of
+// its group to pass. A XOR group is the step preceding the first Xor plus
+// every contiguous Xor step after it. Evaluation short-circuits within
+// contiguous segments where possible to avoid wasted work:
 //   - AND: on first failure, later AND steps are skipped until an OR
 //   - OR: on first success, later OR steps are skipped until an AND
+//   - XOR: once two group members pass, the rest of the group is skipped
 //
 // Message policy:
 //   - AND: collects failures up to and including the first failure
 //   - OR: collects all failures if all fail; clears when any passes
+//   - XOR: collects all failures if none pass; clears when exactly one
+//     passes; reports "exactly one must be satisfied" when several pass
 type FluentValidator struct {
-	steps []chainedStep
+	steps          []chainedStep
+	translator     Translator
+	maxMessages    int
+	keepOrFailures bool
 }
 
 // New creates a new FluentValidator instance.
@@ -48,13 +231,40 @@ func New() *FluentValidator {
 	return &FluentValidator{steps: make([]chainedStep, 0, 4)}
 }
 
+// A chain is itself a Validator, so it can be nested as a single step.
+var _ Validator = (*FluentValidator)(nil)
+
+// Group starts a sub-chain seeded with v. Passed as a step to another
+// chain, the whole group is evaluated as one unit before being combined
+// with its neighbor's operator, which gives it precedence over the flat
+// left-to-right evaluation:
+//
+//	// A AND (B OR C)
+//	New().And(a).And(Group(b).Or(c))
+func Group(v Validator) *FluentValidator {
+	return New().And(v)
+}
+
 type logicalOp uint8
 
 const (
 	opAnd logicalOp = iota
 	opOr
+	opXor
 )
 
+// String returns the operator name as used in rule specs and traces.
+func (op logicalOp) String() string {
+	switch op {
+	case opOr:
+		return "or"
+	case opXor:
+		return "xor"
+	default:
+		return "and"
+	}
+}
+
 type chainedStep struct {
 	validator Validator
 	op        logicalOp
@@ -74,25 +284,208 @@ func (f *FluentValidator) Or(v Validator) *FluentValidator {
 	return f
 }
 
-// Validate evaluates the chain left-to-right, applying AND/OR semantics.
+// WithTranslator sets a Translator used to render failure messages of the
+// chain and returns the same builder for fluent chaining.
+func (f *FluentValidator) WithTranslator(t Translator) *FluentValidator {
+	f.translator = t
+	return f
+}
+
+// MaxMessages caps the number of failure messages the chain aggregates.
+// Once n messages are collected further ones are dropped and a final
+// "... and N more" entry reports how many. n <= 0 means unlimited, the
+// default. It returns the same builder for fluent chaining.
+func (f *FluentValidator) MaxMessages(n int) *FluentValidator {
+	f.maxMessages = n
+	return f
+}
+
+// KeepOrFailures makes the chain keep the messages an OR clears when one of
+// its members passes, reporting them in ValidationResult.Diagnostics
+// whether or not the chain as a whole is valid. It returns the same
+// builder for fluent chaining.
+func (f *FluentValidator) KeepOrFailures() *FluentValidator {
+	f.keepOrFailures = true
+	return f
+}
+
+// Xor adds a validator combined with XOR semantics to the chain and
+// returns the same builder for fluent chaining. The group it joins is
+// valid only when exactly one of its members passes.
+func (f *FluentValidator) Xor(v Validator) *FluentValidator {
+	f.steps = append(f.steps, chainedStep{validator: v, op: opXor})
+	return f
+}
+
+// Validate evaluates the chain left-to-right, applying AND/OR/XOR semantics.
 // It short-circuits where possible and returns a ValidationResult
 // indicating overall validity. When invalid, Message aggregates failure
 // messages encountered according to the logical operators.
+//
+// Validate makes *FluentValidator a Validator, so a chain can be passed to
+// And, Or or Xor of another chain. The nested chain is only evaluated when
+// the outer chain reaches it, and its aggregated messages are merged as
+// those of a single step. A nil chain behaves like an empty one.
 func (f *FluentValidator) Validate() ValidationResult {
-	if len(f.steps) == 0 {
+	return f.validate(nil, nil, evalDefault)
+}
+
+// ValidateFirst is like Validate but reports at most one failure message.
+// When the chain fails at an AND step (or at its first step), that is the
+// first message of the failing step. When it fails because every
+// alternative of an OR group failed, it is the summary "at least one must
+// be satisfied" (code "chain.any_of"), and for an XOR group with no passing
+// member "exactly one must be satisfied" (code "chain.exactly_one"), as
+// when more than one passes. Validity is the same as for Validate.
+func (f *FluentValidator) ValidateFirst() ValidationResult {
+	return f.validate(nil, nil, evalFirst)
+}
+
+// StepTrace records what happened to one step of a chain during
+// ValidateTrace. Evaluated is false for steps skipped by short-circuiting;
+// Valid and Messages are only meaningful for evaluated steps.
+type StepTrace struct {
+	Index     int
+	Op        string
+	Evaluated bool
+	Valid     bool
+	Messages  []string
+}
+
+// ValidateTrace is like Validate but also reports, for every step, whether
+// it ran and what it returned. It is meant for debugging long chains.
+func (f *FluentValidator) ValidateTrace() (ValidationResult, []StepTrace) {
+	if f == nil {
+		return Success(), nil
+	}
+	trace := make([]StepTrace, len(f.steps))
+	for i, step := range f.steps {
+		trace[i] = StepTrace{Index: i, Op: step.op.String()}
+	}
+	return f.validate(trace, nil, evalDefault), trace
+}
+
+// ValidateCount returns the result of Validate together with the number of
+// steps that fail. Unlike Validate it evaluates every step, including those
+// short-circuiting would skip, so the count covers the whole chain (e.g.
+// for a "fields with errors" metric); each step still runs only once.
+func (f *FluentValidator) ValidateCount() (ValidationResult, int) {
+	if f == nil {
+		return Success(), 0
+	}
+	results := make([]ValidationResult, len(f.steps))
+	failed := 0
+	for i, step := range f.steps {
+		results[i] = step.validator.Validate()
+		if !results[i].IsValid {
+			failed++
+		}
+	}
+	return f.validate(nil, results, evalDefault), failed
+}
+
+// ValidateParallel evaluates every step concurrently on at most workers
+// goroutines (GOMAXPROCS when workers <= 0), then combines the results in
+// step order, so messages come out deterministically. When every step is
+// joined with AND, the failures of all steps are collected instead of
+// stopping at the first; chains using Or or Xor combine like Validate.
+// Validators must be safe to run concurrently, which pure rules are.
+func (f *FluentValidator) ValidateParallel(workers int) ValidationResult {
+	if f == nil || len(f.steps) == 0 {
+		return Success()
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(f.steps))
+	results := make([]ValidationResult, len(f.steps))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := int(next.Add(1)) - 1; i < len(f.steps); i = int(next.Add(1)) - 1 {
+				results[i] = f.steps[i].validator.Validate()
+			}
+		}()
+	}
+	wg.Wait()
+	mode := evalCollectAll
+	for _, step := range f.steps[1:] {
+		if step.op != opAnd {
+			mode = evalDefault
+		}
+	}
+	return f.validate(nil, results, mode)
+}
+
+// evalMode selects how validate aggregates messages.
+type evalMode int
+
+const (
+	evalDefault    evalMode = iota
+	evalCollectAll          // no AND short-circuiting: every failing step contributes
+	evalFirst               // one message, summarizing failed OR and XOR groups
+)
+
+// validate implements Validate, recording each evaluated step into trace
+// when it is non-nil. When results is non-nil it holds the already computed
+// result of every step and no validator is run.
+func (f *FluentValidator) validate(trace []StepTrace, results []ValidationResult, mode evalMode) ValidationResult {
+	if f == nil || len(f.steps) == 0 {
 		return Success()
 	}
 
 	accValid := false
-	messages := make([]string, 0, len(f.steps))
+	// Message buffers are sized for the whole chain but only allocated on
+	// the first failure, so passing chains do not allocate.
+	var messages []string
+	var failures []failure
+	// Messages dropped because of MaxMessages
+	dropped := 0
+	// Messages cleared by passing OR members, kept with KeepOrFailures
+	var diagnostics []string
+	collect := func(res ValidationResult) {
+		if messages == nil {
+			messages = make([]string, 0, len(f.steps))
+			failures = make([]failure, 0, len(f.steps))
+		}
+		if mode == evalFirst && len(res.Message) > 1 {
+			res.Message = res.Message[:1]
+		}
+		if f.maxMessages > 0 && len(messages)+len(res.Message) > f.maxMessages {
+			keep := f.maxMessages - len(messages)
+			dropped += len(res.Message) - keep
+			res.Message = res.Message[:keep]
+		}
+		messages, failures = appendFailures(messages, failures, res)
+	}
+	reset := func() {
+		messages, failures, dropped = messages[:0], failures[:0], 0
+	}
+	eval := func(i int) ValidationResult {
+		var res ValidationResult
+		if results != nil {
+			res = results[i]
+		} else {
+			res = f.steps[i].validator.Validate()
+		}
+		if trace != nil {
+			trace[i].Evaluated, trace[i].Valid, trace[i].Messages = true, res.IsValid, res.Message
+		}
+		return res
+	}
+	// Number of passing members in the current XOR group
+	xorPasses := 0
 
 	for i, step := range f.steps {
 		// Always evaluate the first step to seed accumulator
 		if i == 0 {
-			res := step.validator.Validate()
+			res := eval(i)
 			accValid = res.IsValid
 			if !res.IsValid && len(res.Message) > 0 {
-				messages = append(messages, res.Message...)
+				collect(res)
 			}
 			continue
 		}
@@ -100,14 +493,14 @@ func (f *FluentValidator) Validate() ValidationResult {
 		switch step.op {
 		case opAnd:
 			// Short-circuit: if already false, AND cannot change the outcome
-			if !accValid {
+			if !accValid && mode != evalCollectAll {
 				// Skip evaluation to avoid wasted work and extra messages
 				continue
 			}
-			res := step.validator.Validate()
+			res := eval(i)
 			if !res.IsValid && len(res.Message) > 0 {
 				// AND policy: collect up to and including first failure
-				messages = append(messages, res.Message...)
+				collect(res)
 			}
 			accValid = accValid && res.IsValid
 		case opOr:
@@ -116,20 +509,131 @@ func (f *FluentValidator) Validate() ValidationResult {
 				// Skip evaluation to avoid wasted work
 				continue
 			}
-			res := step.validator.Validate()
+			res := eval(i)
 			if res.IsValid {
 				// OR policy: clear failures when chain becomes valid
-				messages = []string{}
+				if f.keepOrFailures {
+					diagnostics = append(diagnostics, messages...)
+				}
+				reset()
+			} else if mode == evalFirst {
+				// Every alternative so far failed: summarize them
+				reset()
+				collect(FailCode("chain.any_of", "at least one must be satisfied"))
 			} else if len(res.Message) > 0 {
 				// Only collected if still failing overall
-				messages = append(messages, res.Message...)
+				collect(res)
 			}
 			accValid = accValid || res.IsValid
+		case opXor:
+			// Seed the group with the outcome of the preceding step
+			if i == 1 || f.steps[i-1].op != opXor {
+				xorPasses = 0
+				if accValid {
+					xorPasses = 1
+				}
+			}
+			// Short-circuit: two passes already make the group fail
+			if xorPasses >= 2 {
+				continue
+			}
+			res := eval(i)
+			if res.IsValid {
+				xorPasses++
+			}
+			switch {
+			case xorPasses == 1:
+				// XOR policy: clear failures when exactly one member passes
+				reset()
+			case xorPasses >= 2, mode == evalFirst:
+				reset()
+				collect(FailCode("chain.exactly_one", "exactly one must be satisfied"))
+			case len(res.Message) > 0:
+				collect(res)
+			}
+			accValid = xorPasses == 1
 		}
 	}
 
 	if accValid {
+		if diagnostics != nil {
+			return ValidationResult{IsValid: true, Message: emptyMessages, Diagnostics: diagnostics}
+		}
+		return Success()
+	}
+	if dropped > 0 {
+		messages, failures = appendFailures(messages, failures,
+			FailCode("chain.more_messages", "... and "+strconv.Itoa(dropped)+" more", "count", dropped))
+	}
+	if messages == nil {
+		messages = emptyMessages
+	}
+	res := ValidationResult{IsValid: false, Message: messages, Diagnostics: diagnostics, failures: failures}
+	if f.translator != nil {
+		return Localize(res, f.translator)
+	}
+	return res
+}
+
+// appendFailures appends the messages of res and their codes, keeping the
+// two slices aligned.
+func appendFailures(messages []string, failures []failure, res ValidationResult) ([]string, []failure) {
+	for i, m := range res.Message {
+		messages = append(messages, m)
+		failures = append(failures, res.failureAt(i))
+	}
+	return messages, failures
+}
+
+// Err evaluates the chain and returns its result as an error, or nil when
+// the chain is valid.
+func (f *FluentValidator) Err() error {
+	return f.Validate().Err()
+}
+
+// ValidateFields runs each validator in rules and buckets the failure
+// messages under its field name. Passing fields are absent from the map,
+// which is nil when every field is valid; the result can be passed
+// straight to NewFieldJSONError.
+func ValidateFields(rules map[string]Validator) (bool, map[string][]string) {
+	results := make(map[string]ValidationResult, len(rules))
+	for field, v := range rules {
+		results[field] = v.Validate()
+	}
+	fields := FieldMessages(results)
+	return fields == nil, fields
+}
+
+// Merge combines independently produced results with AND semantics: it is
+// valid only when every result is valid, and otherwise carries the
+// failures of all invalid results in order. Merging nothing is valid.
+func Merge(results ...ValidationResult) ValidationResult {
+	var messages []string
+	var failures []failure
+	valid := true
+	for _, r := range results {
+		if !r.IsValid {
+			valid = false
+			messages, failures = appendFailures(messages, failures, r)
+		}
+	}
+	if valid {
 		return Success()
 	}
-	return ValidationResult{IsValid: false, Message: messages}
+	if messages == nil {
+		messages = emptyMessages
+	}
+	return ValidationResult{IsValid: false, Message: messages, failures: failures}
+}
+
+// MergeOr combines results with OR semantics: it is valid as soon as one
+// result is valid, clearing all messages, and otherwise carries every
+// failure. Like an empty chain, merging nothing is valid.
+func MergeOr(results ...ValidationResult) ValidationResult {
+	for _, r := range results {
+		if r.IsValid {
+			return Success()
+		}
+	}
+	return Merge(results...)
 }
@@ -1,8 +1,11 @@
 package validate
 
 import (
+	"encoding/json"
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestValidate(t *testing.T) {
@@ -90,6 +93,110 @@ func TestValidate(t *testing.T) {
 			wantValid:   false,
 			wantMessage: []string{"e1"},
 		},
+		{
+			name: "XOR: both pass",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("a")).
+					Xor(hasMinLen("ab", 2))
+			},
+			wantValid:   false,
+			wantMessage: []string{"exactly one must be satisfied"},
+		},
+		{
+			name: "XOR: first passes",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("a")).
+					Xor(hasMinLen("a", 2))
+			},
+			wantValid:   true,
+			wantMessage: []string{},
+		},
+		{
+			name: "XOR: second passes",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					Xor(hasMinLen("ab", 2))
+			},
+			wantValid:   true,
+			wantMessage: []string{},
+		},
+		{
+			name: "XOR: neither passes",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					Xor(hasMinLen("a", 2))
+			},
+			wantValid:   false,
+			wantMessage: []string{"must not be empty", "too short"},
+		},
+		{
+			name: "XOR: group of three with one pass",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					Xor(hasMinLen("a", 2)).
+					Xor(isNonEmpty("x"))
+			},
+			wantValid:   true,
+			wantMessage: []string{},
+		},
+		{
+			name: "XOR: failing AND after passing group",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					Xor(isNonEmpty("x")).
+					And(hasMinLen("a", 2))
+			},
+			wantValid:   false,
+			wantMessage: []string{"too short"},
+		},
+		{
+			name: "flat: (A AND B) OR C",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					And(hasMinLen("a", 2)).
+					Or(isNonEmpty("x"))
+			},
+			wantValid:   true,
+			wantMessage: []string{},
+		},
+		{
+			name: "Group: A AND (B OR C) with A failing",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					And(Group(hasMinLen("a", 2)).Or(isNonEmpty("x")))
+			},
+			wantValid:   false,
+			wantMessage: []string{"must not be empty"},
+		},
+		{
+			name: "Group: A AND (B OR C) with group failing",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("x")).
+					And(Group(hasMinLen("a", 2)).Or(isNonEmpty("")))
+			},
+			wantValid:   false,
+			wantMessage: []string{"too short", "must not be empty"},
+		},
+		{
+			name: "Group: nested groups (A OR (B AND (C OR D)))",
+			build: func() *FluentValidator {
+				return New().
+					And(isNonEmpty("")).
+					Or(Group(isNonEmpty("x")).
+						And(Group(hasMinLen("a", 2)).Or(hasMinLen("abc", 3))))
+			},
+			wantValid:   true,
+			wantMessage: []string{},
+		},
 	}
 
 	for _, tc := range tests {
@@ -106,3 +213,488 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestNestedChains(t *testing.T) {
+	t.Parallel()
+
+	calls := map[string]int{}
+	step := func(name string, ok bool) ValidatorFunc {
+		return func() ValidationResult {
+			calls[name]++
+			if !ok {
+				return Fail(name + " failed")
+			}
+			return Success()
+		}
+	}
+
+	// outer: A AND (B OR (C AND D))
+	inner := New().And(step("c", true)).And(step("d", false))
+	middle := New().And(step("b", false)).Or(inner)
+	outer := New().And(step("a", true)).And(middle)
+
+	res := outer.Validate()
+	if res.IsValid {
+		t.Fatalf("expected invalid")
+	}
+	if want := []string{"b failed", "d failed"}; !reflect.DeepEqual(res.Message, want) {
+		t.Fatalf("messages=%v want %v", res.Message, want)
+	}
+
+	// A failing outer AND short-circuits the nested chains entirely.
+	for k := range calls {
+		delete(calls, k)
+	}
+	res = New().And(step("a", false)).And(middle).Validate()
+	if res.IsValid || !reflect.DeepEqual(res.Message, []string{"a failed"}) {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	if calls["b"] != 0 || calls["c"] != 0 || calls["d"] != 0 {
+		t.Fatalf("nested steps evaluated after short-circuit: %v", calls)
+	}
+
+	// A passing nested OR clears the failures collected inside it.
+	res = New().And(step("a", true)).And(New().Or(step("b", false)).Or(New().And(step("c", true)))).Validate()
+	if !res.IsValid || len(res.Message) != 0 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	var nilChain *FluentValidator
+	if res := New().And(nilChain).Validate(); !res.IsValid {
+		t.Fatalf("nil chain should be valid, got %+v", res)
+	}
+}
+
+func TestValidateTrace(t *testing.T) {
+	t.Parallel()
+
+	// A AND B AND C OR D, with B failing: C is skipped, D rescues the chain.
+	f := New().
+		And(NonEmpty("x")).
+		And(MinLen("a", 2)).
+		And(NonEmpty("y")).
+		Or(NonEmpty("z"))
+	res, trace := f.ValidateTrace()
+	if want := f.Validate(); !reflect.DeepEqual(res, want) {
+		t.Fatalf("trace result=%+v want %+v", res, want)
+	}
+	want := []StepTrace{
+		{Index: 0, Op: "and", Evaluated: true, Valid: true, Messages: []string{}},
+		{Index: 1, Op: "and", Evaluated: true, Valid: false, Messages: []string{"too short: min 2"}},
+		{Index: 2, Op: "and", Evaluated: false},
+		{Index: 3, Op: "or", Evaluated: true, Valid: true, Messages: []string{}},
+	}
+	if !reflect.DeepEqual(trace, want) {
+		t.Fatalf("trace=%+v want %+v", trace, want)
+	}
+
+	// A passing OR skips the remaining OR steps.
+	_, trace = New().Or(NonEmpty("x")).Or(NonEmpty("")).ValidateTrace()
+	if !trace[0].Evaluated || trace[1].Evaluated {
+		t.Fatalf("trace=%+v", trace)
+	}
+
+	var nilChain *FluentValidator
+	if res, trace := nilChain.ValidateTrace(); !res.IsValid || trace != nil {
+		t.Fatalf("nil chain: res=%+v trace=%v", res, trace)
+	}
+}
+
+func TestValidateFirst(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		f         *FluentValidator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"valid", New().And(NonEmpty("x")), true, []string{}},
+		{"AND first failure", New().And(NonEmpty("x")).And(MinLen("a", 2)).And(NonEmpty("")), false, []string{"too short: min 2"}},
+		{"OR all fail", New().Or(NonEmpty("")).Or(MinLen("a", 2)).Or(IntMin(1, 2)), false, []string{"at least one must be satisfied"}},
+		{"AND then OR all fail", New().And(NonEmpty("x")).And(NonEmpty("")).Or(MinLen("a", 2)), false, []string{"at least one must be satisfied"}},
+		{"OR all fail then rescued", New().And(NonEmpty("")).Or(MinLen("a", 2)).Or(NonEmpty("x")), true, []string{}},
+		{"OR rescued then AND fails", New().Or(NonEmpty("")).Or(NonEmpty("x")).And(MinLen("a", 2)), false, []string{"too short: min 2"}},
+		{"multi-message step", New().And(All(NonEmpty(""), MinLen("", 1))), false, []string{"must not be empty"}},
+		{"XOR none pass", New().And(NonEmpty("")).Xor(MinLen("a", 2)), false, []string{"exactly one must be satisfied"}},
+		{"XOR two pass", New().And(NonEmpty("x")).Xor(MinLen("ab", 2)), false, []string{"exactly one must be satisfied"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.f.ValidateFirst()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+	if res := New().Or(MinLen("a", 2)).Or(NonEmpty("")).ValidateFirst(); !res.HasCode("chain.any_of") || len(res.Failures()) != 1 {
+		t.Fatalf("failures=%v", res.Failures())
+	}
+	if res := New().And(NonEmpty("")).And(MinLen("a", 2)).ValidateFirst(); !res.HasCode("string.non_empty") || len(res.Failures()) != 1 {
+		t.Fatalf("failures=%v", res.Failures())
+	}
+}
+
+func TestValidateCount(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	step := func(ok bool) ValidatorFunc {
+		return func() ValidationResult {
+			calls++
+			if !ok {
+				return Fail("failed")
+			}
+			return Success()
+		}
+	}
+	tests := []struct {
+		name      string
+		f         *FluentValidator
+		wantValid bool
+		wantCount int
+		wantCalls int
+	}{
+		{"no failures", New().And(step(true)).And(step(true)), true, 0, 2},
+		{"one failure", New().And(step(true)).And(step(false)), false, 1, 2},
+		{"skipped AND steps still counted", New().And(step(false)).And(step(false)).And(step(false)), false, 3, 3},
+		{"rescued by OR", New().And(step(false)).Or(step(true)).Or(step(false)), true, 2, 3},
+		{"empty", New(), true, 0, 0},
+	}
+	for _, tc := range tests {
+		calls = 0
+		res, n := tc.f.ValidateCount()
+		if res.IsValid != tc.wantValid || n != tc.wantCount || calls != tc.wantCalls {
+			t.Fatalf("%s: valid=%v count=%d calls=%d want %v, %d, %d", tc.name, res.IsValid, n, calls, tc.wantValid, tc.wantCount, tc.wantCalls)
+		}
+	}
+
+	// The result matches Validate despite evaluating every step.
+	f := New().And(NonEmpty("")).And(MinLen("a", 2)).Or(IntMin(1, 2))
+	res, n := f.ValidateCount()
+	if want := f.Validate(); !reflect.DeepEqual(res, want) || n != 3 {
+		t.Fatalf("result=%+v count=%d want %+v, 3", res, n, want)
+	}
+}
+
+func TestMaxMessages(t *testing.T) {
+	t.Parallel()
+	orChain := func(max int) *FluentValidator {
+		f := New().MaxMessages(max)
+		for i := 0; i < 5; i++ {
+			f.Or(MinLen("", i+1))
+		}
+		return f
+	}
+	tests := []struct {
+		name    string
+		f       *FluentValidator
+		wantMsg []string
+	}{
+		{"unlimited by default", orChain(0), []string{"too short: min 1", "too short: min 2", "too short: min 3", "too short: min 4", "too short: min 5"}},
+		{"cap applied", orChain(2), []string{"too short: min 1", "too short: min 2", "... and 3 more"}},
+		{"cap equal to count", orChain(5), []string{"too short: min 1", "too short: min 2", "too short: min 3", "too short: min 4", "too short: min 5"}},
+		{"cap splits a multi-message step", New().MaxMessages(2).Or(NonEmpty("")).Or(All(NonEmpty(""), NonEmpty(""))), []string{"must not be empty", "must not be empty", "... and 1 more"}},
+		{"passing OR resets the count", New().MaxMessages(1).Or(NonEmpty("")).Or(NonEmpty("")).Or(NonEmpty("x")).And(MinLen("a", 2)), []string{"too short: min 2"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.f.Validate()
+			if res.IsValid {
+				t.Fatalf("expected invalid")
+			}
+			if !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+
+	if res := orChain(2).Validate(); !res.HasCode("chain.more_messages") {
+		t.Fatalf("summary line has no code: %v", res.Failures())
+	}
+}
+
+func TestResultAsError(t *testing.T) {
+	t.Parallel()
+
+	var err error = Fail("e1", "e2")
+	if got, want := err.Error(), "e1\ne2"; got != want {
+		t.Fatalf("Error()=%q want %q", got, want)
+	}
+	if got := Success().Error(); got != "" {
+		t.Fatalf("valid Error()=%q want empty", got)
+	}
+
+	if !(ValidationResult{}).IsZero() {
+		t.Fatalf("zero result should report IsZero")
+	}
+	if Success().IsZero() || Fail("e1").IsZero() || Fail().IsZero() {
+		t.Fatalf("produced results should not report IsZero")
+	}
+
+	// Field access is unchanged.
+	res := Fail("e1")
+	if res.IsValid || res.Message[0] != "e1" {
+		t.Fatalf("unexpected fields %#v", res)
+	}
+}
+
+func TestResultJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		res  ValidationResult
+		want string
+	}{
+		{"valid", Success(), `{"valid":true,"errors":[]}`},
+		{"invalid", Fail("e1", "e2"), `{"valid":false,"errors":["e1","e2"]}`},
+		{"zero", ValidationResult{}, `{"valid":false,"errors":[]}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.res)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(b) != tc.want {
+				t.Fatalf("json=%s want %s", b, tc.want)
+			}
+			var back ValidationResult
+			if err := json.Unmarshal(b, &back); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if back.IsValid != tc.res.IsValid || len(back.Message) != len(tc.res.Message) {
+				t.Fatalf("round trip=%#v want %#v", back, tc.res)
+			}
+		})
+	}
+}
+
+func TestResultFailures(t *testing.T) {
+	t.Parallel()
+
+	res := New().
+		Or(MinLen("ab", 3)).
+		Or(ValidatorFunc(func() ValidationResult { return Fail("custom") })).
+		Or(IntBetween(0, 1, 5)).
+		Validate()
+	want := []Failure{
+		{Code: "string.min_len", Message: "too short: min 3", Params: map[string]any{"min": 3}},
+		{Code: "", Message: "custom"},
+		{Code: "number.between", Message: "must be between 1 and 5", Params: map[string]any{"min": 1, "max": 5}},
+	}
+	if got := res.Failures(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("failures=%#v want %#v", got, want)
+	}
+	if !res.HasCode("number.between") || res.HasCode("string.max_len") {
+		t.Fatalf("HasCode mismatch for %v", res.Failures())
+	}
+	if got := Success().Failures(); got != nil {
+		t.Fatalf("valid result failures=%v want nil", got)
+	}
+
+	// A passing OR clears the codes together with the messages.
+	res = New().And(NonEmpty("")).Or(NonEmpty("x")).And(MaxLen("abc", 2)).Validate()
+	if got := res.Failures(); len(got) != 1 || got[0].Code != "string.max_len" {
+		t.Fatalf("failures=%#v", got)
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		rules      map[string]Validator
+		wantValid  bool
+		wantFields map[string][]string
+	}{
+		{"no rules", nil, true, nil},
+		{"all pass", map[string]Validator{"name": NonEmpty("Ada"), "age": IntMin(30, 18)}, true, nil},
+		{
+			name: "mixed",
+			rules: map[string]Validator{
+				"name":  NonEmpty("Ada"),
+				"email": EmailValid("nope"),
+				"age":   New().And(IntMin(12, 18)),
+				"tags":  New().Or(NonEmpty("")).Or(MinLen("a", 2)),
+			},
+			wantFields: map[string][]string{
+				"email": {"invalid email"},
+				"age":   {"must be >= 18"},
+				"tags":  {"must not be empty", "too short: min 2"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, fields := ValidateFields(tc.rules)
+			if ok != tc.wantValid {
+				t.Fatalf("valid=%v want %v", ok, tc.wantValid)
+			}
+			if !reflect.DeepEqual(fields, tc.wantFields) {
+				t.Fatalf("fields=%v want %v", fields, tc.wantFields)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	a := Fail("a1", "a2")
+	b := MinLen("x", 2)()
+	tests := []struct {
+		name      string
+		res       ValidationResult
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"Merge none", Merge(), true, []string{}},
+		{"Merge all valid", Merge(Success(), Success()), true, []string{}},
+		{"Merge mixed", Merge(a, Success(), b), false, []string{"a1", "a2", "too short: min 2"}},
+		{"Merge zero result", Merge(Success(), ValidationResult{}), false, []string{}},
+		{"MergeOr none", MergeOr(), true, []string{}},
+		{"MergeOr one valid clears", MergeOr(a, Success(), b), true, []string{}},
+		{"MergeOr all invalid", MergeOr(a, b), false, []string{"a1", "a2", "too short: min 2"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", tc.res.IsValid, tc.wantValid)
+			}
+			if !reflect.DeepEqual(tc.res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", tc.res.Message, tc.wantMsg)
+			}
+		})
+	}
+	if !Merge(a, b).HasCode("string.min_len") {
+		t.Fatalf("Merge dropped failure codes")
+	}
+}
+
+func TestValidateAllocations(t *testing.T) {
+	if res := Success(); res.Message == nil || len(res.Message) != 0 {
+		t.Fatalf("Success messages=%#v want empty non-nil slice", res.Message)
+	}
+	// Appending to a shared success slice must not leak into other results.
+	_ = append(Success().Message, "x")
+	if len(Success().Message) != 0 {
+		t.Fatalf("shared success slice was modified")
+	}
+
+	f := New().And(NonEmpty("x")).And(MinLen("abc", 2)).And(IntMin(3, 1))
+	if n := testing.AllocsPerRun(100, func() { _ = f.Validate() }); n != 0 {
+		t.Fatalf("passing chain allocated %v times per run, want 0", n)
+	}
+}
+
+func TestValidateParallel(t *testing.T) {
+	t.Parallel()
+	slow := func(d time.Duration, v Validator) Validator {
+		return ValidatorFunc(func() ValidationResult {
+			time.Sleep(d)
+			return v.Validate()
+		})
+	}
+	tests := []struct {
+		name      string
+		f         *FluentValidator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"nil chain", nil, true, nil},
+		{"all pass", New().And(NonEmpty("x")).And(MinLen("abc", 2)), true, nil},
+		// Later steps finish first; messages still follow step order.
+		{"and collects all in order", New().
+			And(slow(30*time.Millisecond, NonEmpty(""))).
+			And(slow(20*time.Millisecond, MinLen("a", 2))).
+			And(NonEmpty("ok")).
+			And(slow(0, IntMin(1, 2))), false, []string{"must not be empty", "too short: min 2", "must be >= 2"}},
+		{"or keeps chain semantics", New().And(NonEmpty("")).Or(NonEmpty("x")), true, nil},
+		{"or all fail", New().And(NonEmpty("")).Or(MinLen("a", 2)), false, []string{"must not be empty", "too short: min 2"}},
+		{"max messages", New().And(NonEmpty("")).And(MinLen("a", 2)).And(IntMin(1, 2)).MaxMessages(1), false, []string{"must not be empty", "... and 2 more"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, workers := range []int{0, 1, 2, 8} {
+				res := tc.f.ValidateParallel(workers)
+				if res.IsValid != tc.wantValid {
+					t.Fatalf("workers=%d: valid=%v want %v", workers, res.IsValid, tc.wantValid)
+				}
+				if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+					t.Fatalf("workers=%d: msg=%v want %v", workers, res.Message, tc.wantMsg)
+				}
+			}
+		})
+	}
+
+	var calls atomic.Int32
+	f := New()
+	for i := 0; i < 20; i++ {
+		f.And(ValidatorFunc(func() ValidationResult {
+			calls.Add(1)
+			return Fail("x")
+		}))
+	}
+	if res := f.ValidateParallel(4); len(res.Message) != 20 || calls.Load() != 20 {
+		t.Fatalf("got %d messages and %d calls, want 20 each", len(res.Message), calls.Load())
+	}
+}
+
+func TestKeepOrFailures(t *testing.T) {
+	t.Parallel()
+	build := func(keep bool, v ...Validator) *FluentValidator {
+		f := New().And(v[0]).Or(v[1]).And(v[2])
+		if keep {
+			f.KeepOrFailures()
+		}
+		return f
+	}
+	tests := []struct {
+		name      string
+		keep      bool
+		v         []Validator
+		wantValid bool
+		wantMsg   []string
+		wantDiag  []string
+	}{
+		{"default clears or failures", false, []Validator{NonEmpty(""), NonEmpty("x"), MinLen("a", 2)}, false, []string{"too short: min 2"}, nil},
+		{"keep surfaces or failures", true, []Validator{NonEmpty(""), NonEmpty("x"), MinLen("a", 2)}, false, []string{"too short: min 2"}, []string{"must not be empty"}},
+		{"keep on valid chain", true, []Validator{NonEmpty(""), NonEmpty("x"), MinLen("abc", 2)}, true, nil, []string{"must not be empty"}},
+		{"default valid chain", false, []Validator{NonEmpty(""), NonEmpty("x"), MinLen("abc", 2)}, true, nil, nil},
+		{"keep without or failures", true, []Validator{NonEmpty("x"), NonEmpty(""), MinLen("a", 2)}, false, []string{"too short: min 2"}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := build(tc.keep, tc.v...).Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if res.Message == nil {
+				t.Fatalf("msg=nil want non-nil")
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+			if !reflect.DeepEqual(res.Diagnostics, tc.wantDiag) {
+				t.Fatalf("diagnostics=%v want %v", res.Diagnostics, tc.wantDiag)
+			}
+		})
+	}
+
+	res := build(true, NonEmpty(""), NonEmpty("x"), MinLen("a", 2)).Validate()
+	b, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if want := `{"valid":false,"errors":["too short: min 2"],"diagnostics":["must not be empty"]}`; string(b) != want {
+		t.Fatalf("json=%s want %s", b, want)
+	}
+	var back ValidationResult
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(back.Diagnostics, res.Diagnostics) {
+		t.Fatalf("round trip=%#v want %#v", back, res)
+	}
+}
@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// vatPatterns holds the structure of VAT numbers per country, without the
+// country prefix. Check digits are not verified.
+var vatPatterns = map[string]*regexp.Regexp{
+	"DE": regexp.MustCompile(`^[0-9]{9}$`),
+	"FR": regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}[0-9]{9}$`),
+	"GB": regexp.MustCompile(`^(?:[0-9]{9}|[0-9]{12}|GD[0-4][0-9]{2}|HA[5-9][0-9]{2})$`),
+	"IT": regexp.MustCompile(`^[0-9]{11}$`),
+	"ES": regexp.MustCompile(`^(?:[A-Z][0-9]{7}[A-Z0-9]|[0-9]{8}[A-Z])$`),
+	"NL": regexp.MustCompile(`^[0-9]{9}B[0-9]{2}$`),
+}
+
+// IsVATNumber validates the structure of an EU-style VAT number for
+// country (DE, FR, GB, IT, ES or NL). Spaces and an optional leading
+// country code are ignored, so "DE 123 456 789" and "123456789" are both
+// accepted for DE.
+func IsVATNumber(s, country string) ValidatorFunc {
+	return func() ValidationResult {
+		country := strings.ToUpper(country)
+		re, ok := vatPatterns[country]
+		if !ok {
+			return FailCode("vat.country", "VAT numbers not supported for "+country, "country", country)
+		}
+		v := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+		v = strings.TrimPrefix(v, country)
+		if !re.MatchString(v) {
+			return FailCode("vat.invalid", "invalid VAT number for "+country, "country", country)
+		}
+		return Success()
+	}
+}
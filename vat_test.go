@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsVATNumber(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		v         Validator
+		wantValid bool
+		wantMsg   []string
+	}{
+		{"DE ok", IsVATNumber("DE123456789", "DE"), true, nil},
+		{"DE spaces without prefix", IsVATNumber("123 456 789", "de"), true, nil},
+		{"DE too short", IsVATNumber("DE12345678", "DE"), false, []string{"invalid VAT number for DE"}},
+		{"FR ok", IsVATNumber("FR 40 303265045", "FR"), true, nil},
+		{"FR letter key", IsVATNumber("FRK7399859412", "FR"), true, nil},
+		{"FR bad key", IsVATNumber("FRI7399859412", "FR"), false, []string{"invalid VAT number for FR"}},
+		{"GB standard", IsVATNumber("GB980780684", "GB"), true, nil},
+		{"GB branch", IsVATNumber("GB 980 7806 84 001", "GB"), true, nil},
+		{"GB government", IsVATNumber("GBGD001", "GB"), true, nil},
+		{"GB bad", IsVATNumber("GB98078068", "GB"), false, []string{"invalid VAT number for GB"}},
+		{"IT ok", IsVATNumber("IT12345678901", "IT"), true, nil},
+		{"IT letters", IsVATNumber("IT1234567890A", "IT"), false, []string{"invalid VAT number for IT"}},
+		{"ES company", IsVATNumber("ESA12345674", "ES"), true, nil},
+		{"ES individual", IsVATNumber("ES12345678Z", "ES"), true, nil},
+		{"ES all digits", IsVATNumber("ES123456789", "ES"), false, []string{"invalid VAT number for ES"}},
+		{"NL ok", IsVATNumber("NL123456789B01", "NL"), true, nil},
+		{"NL missing B", IsVATNumber("NL123456789001", "NL"), false, []string{"invalid VAT number for NL"}},
+		{"wrong country prefix", IsVATNumber("FR123456789", "DE"), false, []string{"invalid VAT number for DE"}},
+		{"unsupported country", IsVATNumber("PL1234567890", "PL"), false, []string{"VAT numbers not supported for PL"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.v.Validate()
+			if res.IsValid != tc.wantValid {
+				t.Fatalf("valid=%v want %v", res.IsValid, tc.wantValid)
+			}
+			if tc.wantMsg != nil && !reflect.DeepEqual(res.Message, tc.wantMsg) {
+				t.Fatalf("msg=%v want %v", res.Message, tc.wantMsg)
+			}
+		})
+	}
+}